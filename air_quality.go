@@ -0,0 +1,433 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AirQualityRequest represents a request to the Air Quality API.
+type AirQualityRequest struct {
+	location Location
+
+	// Metrics to request
+	hourlyMetrics  []AirQualityMetric
+	currentMetrics []AirQualityMetric
+
+	// Time options
+	timezone      string
+	forecastDays  int
+	pastDays      int
+	forecastHours int
+	pastHours     int
+
+	// Date range options
+	startDate string
+	endDate   string
+	startHour string
+	endHour   string
+
+	// Other options
+	domains       AirQualityDomain
+	cellSelection CellSelection
+}
+
+// NewAirQualityRequest creates a new AirQualityRequest for the given coordinates.
+func NewAirQualityRequest(lat, lon float64) (*AirQualityRequest, error) {
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &AirQualityRequest{
+		location: loc,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *AirQualityRequest) WithLocation(loc Location) *AirQualityRequest {
+	r.location = loc
+	return r
+}
+
+// WithHourly adds hourly metrics to the request.
+func (r *AirQualityRequest) WithHourly(metrics ...AirQualityMetric) *AirQualityRequest {
+	r.hourlyMetrics = append(r.hourlyMetrics, metrics...)
+	return r
+}
+
+// WithCurrent adds current air quality metrics to the request.
+func (r *AirQualityRequest) WithCurrent(metrics ...AirQualityMetric) *AirQualityRequest {
+	r.currentMetrics = append(r.currentMetrics, metrics...)
+	return r
+}
+
+// WithTimezone sets the timezone for the response.
+// Use "auto" to automatically detect the timezone based on coordinates.
+func (r *AirQualityRequest) WithTimezone(tz string) *AirQualityRequest {
+	r.timezone = tz
+	return r
+}
+
+// WithForecastDays sets the number of forecast days (0-7).
+func (r *AirQualityRequest) WithForecastDays(days int) *AirQualityRequest {
+	r.forecastDays = days
+	return r
+}
+
+// WithPastDays sets the number of past days to include (0-92).
+func (r *AirQualityRequest) WithPastDays(days int) *AirQualityRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithForecastHours sets the number of forecast hours.
+func (r *AirQualityRequest) WithForecastHours(hours int) *AirQualityRequest {
+	r.forecastHours = hours
+	return r
+}
+
+// WithPastHours sets the number of past hours to include.
+func (r *AirQualityRequest) WithPastHours(hours int) *AirQualityRequest {
+	r.pastHours = hours
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *AirQualityRequest) WithDateRange(startDate, endDate string) *AirQualityRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithHourRange sets a specific hour range.
+// Times should be in ISO8601 format (yyyy-mm-ddThh:mm).
+func (r *AirQualityRequest) WithHourRange(startHour, endHour string) *AirQualityRequest {
+	r.startHour = startHour
+	r.endHour = endHour
+	return r
+}
+
+// WithDomains selects the CAMS model domain.
+func (r *AirQualityRequest) WithDomains(domains AirQualityDomain) *AirQualityRequest {
+	r.domains = domains
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+func (r *AirQualityRequest) WithCellSelection(selection CellSelection) *AirQualityRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// buildURL builds the URL for an air quality request.
+func (r *AirQualityRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Metrics
+	if len(r.hourlyMetrics) > 0 {
+		params.Set("hourly", joinMetrics(r.hourlyMetrics))
+	}
+	if len(r.currentMetrics) > 0 {
+		params.Set("current", joinMetrics(r.currentMetrics))
+	}
+
+	// Time options
+	if r.timezone != "" {
+		params.Set("timezone", r.timezone)
+	}
+	if r.forecastDays > 0 {
+		params.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+	if r.forecastHours > 0 {
+		params.Set("forecast_hours", strconv.Itoa(r.forecastHours))
+	}
+	if r.pastHours > 0 {
+		params.Set("past_hours", strconv.Itoa(r.pastHours))
+	}
+
+	// Date/time range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+	if r.startHour != "" {
+		params.Set("start_hour", r.startHour)
+	}
+	if r.endHour != "" {
+		params.Set("end_hour", r.endHour)
+	}
+
+	// Other options
+	if r.domains != "" {
+		params.Set("domains", string(r.domains))
+	}
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// AirQuality contains the response from the Air Quality API.
+type AirQuality struct {
+	// Location information
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// Current air quality conditions
+	Current      *AirQualityCurrentData `json:"-"` // parsed separately
+	CurrentUnits *AirQualityUnits       `json:"current_units,omitempty"`
+
+	// Hourly data
+	Hourly      *AirQualityHourlyData `json:"-"` // parsed separately
+	HourlyUnits *AirQualityUnits      `json:"hourly_units,omitempty"`
+}
+
+// AirQualityHourlyData contains hourly air quality data.
+// Missing data points are stored as NaN; see Series.
+type AirQualityHourlyData struct {
+	// Time contains timestamps for each data point.
+	Times []time.Time `json:"-"` // parsed separately from "time" field
+
+	// Particulate matter
+	PM10 Series `json:"pm10,omitempty"`
+	PM25 Series `json:"pm2_5,omitempty"`
+
+	// Gases
+	CarbonMonoxide  Series `json:"carbon_monoxide,omitempty"`
+	CarbonDioxide   Series `json:"carbon_dioxide,omitempty"`
+	NitrogenDioxide Series `json:"nitrogen_dioxide,omitempty"`
+	SulphurDioxide  Series `json:"sulphur_dioxide,omitempty"`
+	Ozone           Series `json:"ozone,omitempty"`
+	Ammonia         Series `json:"ammonia,omitempty"`
+	Methane         Series `json:"methane,omitempty"`
+
+	// Aerosols and dust
+	AerosolOpticalDepth Series `json:"aerosol_optical_depth,omitempty"`
+	Dust                Series `json:"dust,omitempty"`
+
+	// UV index
+	UVIndex         Series `json:"uv_index,omitempty"`
+	UVIndexClearSky Series `json:"uv_index_clear_sky,omitempty"`
+
+	// Pollen
+	AlderPollen   Series `json:"alder_pollen,omitempty"`
+	BirchPollen   Series `json:"birch_pollen,omitempty"`
+	GrassPollen   Series `json:"grass_pollen,omitempty"`
+	MugwortPollen Series `json:"mugwort_pollen,omitempty"`
+	OlivePollen   Series `json:"olive_pollen,omitempty"`
+	RagweedPollen Series `json:"ragweed_pollen,omitempty"`
+
+	// European Air Quality Index
+	EuropeanAQI                Series `json:"european_aqi,omitempty"`
+	EuropeanAQIPM25            Series `json:"european_aqi_pm2_5,omitempty"`
+	EuropeanAQIPM10            Series `json:"european_aqi_pm10,omitempty"`
+	EuropeanAQINitrogenDioxide Series `json:"european_aqi_nitrogen_dioxide,omitempty"`
+	EuropeanAQIOzone           Series `json:"european_aqi_ozone,omitempty"`
+	EuropeanAQISulphurDioxide  Series `json:"european_aqi_sulphur_dioxide,omitempty"`
+
+	// United States Air Quality Index
+	USAQI                Series `json:"us_aqi,omitempty"`
+	USAQIPM25            Series `json:"us_aqi_pm2_5,omitempty"`
+	USAQIPM10            Series `json:"us_aqi_pm10,omitempty"`
+	USAQINitrogenDioxide Series `json:"us_aqi_nitrogen_dioxide,omitempty"`
+	USAQIOzone           Series `json:"us_aqi_ozone,omitempty"`
+	USAQISulphurDioxide  Series `json:"us_aqi_sulphur_dioxide,omitempty"`
+	USAQICarbonMonoxide  Series `json:"us_aqi_carbon_monoxide,omitempty"`
+}
+
+// AirQualityCurrentData contains current air quality conditions.
+type AirQualityCurrentData struct {
+	// Time of the current observation.
+	Time time.Time `json:"-"` // parsed separately
+
+	// Interval is the time interval in seconds used for aggregations.
+	Interval int `json:"interval,omitempty"`
+
+	// Particulate matter
+	PM10 *float64 `json:"pm10,omitempty"`
+	PM25 *float64 `json:"pm2_5,omitempty"`
+
+	// Gases
+	CarbonMonoxide  *float64 `json:"carbon_monoxide,omitempty"`
+	CarbonDioxide   *float64 `json:"carbon_dioxide,omitempty"`
+	NitrogenDioxide *float64 `json:"nitrogen_dioxide,omitempty"`
+	SulphurDioxide  *float64 `json:"sulphur_dioxide,omitempty"`
+	Ozone           *float64 `json:"ozone,omitempty"`
+	Ammonia         *float64 `json:"ammonia,omitempty"`
+	Methane         *float64 `json:"methane,omitempty"`
+
+	// Aerosols and dust
+	AerosolOpticalDepth *float64 `json:"aerosol_optical_depth,omitempty"`
+	Dust                *float64 `json:"dust,omitempty"`
+
+	// UV index
+	UVIndex         *float64 `json:"uv_index,omitempty"`
+	UVIndexClearSky *float64 `json:"uv_index_clear_sky,omitempty"`
+
+	// Pollen
+	AlderPollen   *float64 `json:"alder_pollen,omitempty"`
+	BirchPollen   *float64 `json:"birch_pollen,omitempty"`
+	GrassPollen   *float64 `json:"grass_pollen,omitempty"`
+	MugwortPollen *float64 `json:"mugwort_pollen,omitempty"`
+	OlivePollen   *float64 `json:"olive_pollen,omitempty"`
+	RagweedPollen *float64 `json:"ragweed_pollen,omitempty"`
+
+	// European Air Quality Index
+	EuropeanAQI                *float64 `json:"european_aqi,omitempty"`
+	EuropeanAQIPM25            *float64 `json:"european_aqi_pm2_5,omitempty"`
+	EuropeanAQIPM10            *float64 `json:"european_aqi_pm10,omitempty"`
+	EuropeanAQINitrogenDioxide *float64 `json:"european_aqi_nitrogen_dioxide,omitempty"`
+	EuropeanAQIOzone           *float64 `json:"european_aqi_ozone,omitempty"`
+	EuropeanAQISulphurDioxide  *float64 `json:"european_aqi_sulphur_dioxide,omitempty"`
+
+	// United States Air Quality Index
+	USAQI                *float64 `json:"us_aqi,omitempty"`
+	USAQIPM25            *float64 `json:"us_aqi_pm2_5,omitempty"`
+	USAQIPM10            *float64 `json:"us_aqi_pm10,omitempty"`
+	USAQINitrogenDioxide *float64 `json:"us_aqi_nitrogen_dioxide,omitempty"`
+	USAQIOzone           *float64 `json:"us_aqi_ozone,omitempty"`
+	USAQISulphurDioxide  *float64 `json:"us_aqi_sulphur_dioxide,omitempty"`
+	USAQICarbonMonoxide  *float64 `json:"us_aqi_carbon_monoxide,omitempty"`
+}
+
+// AirQualityUnits contains unit strings for air quality metrics.
+// It is used for both hourly and current data.
+type AirQualityUnits struct {
+	PM10                       string `json:"pm10,omitempty"`
+	PM25                       string `json:"pm2_5,omitempty"`
+	CarbonMonoxide             string `json:"carbon_monoxide,omitempty"`
+	CarbonDioxide              string `json:"carbon_dioxide,omitempty"`
+	NitrogenDioxide            string `json:"nitrogen_dioxide,omitempty"`
+	SulphurDioxide             string `json:"sulphur_dioxide,omitempty"`
+	Ozone                      string `json:"ozone,omitempty"`
+	Ammonia                    string `json:"ammonia,omitempty"`
+	Methane                    string `json:"methane,omitempty"`
+	AerosolOpticalDepth        string `json:"aerosol_optical_depth,omitempty"`
+	Dust                       string `json:"dust,omitempty"`
+	UVIndex                    string `json:"uv_index,omitempty"`
+	UVIndexClearSky            string `json:"uv_index_clear_sky,omitempty"`
+	AlderPollen                string `json:"alder_pollen,omitempty"`
+	BirchPollen                string `json:"birch_pollen,omitempty"`
+	GrassPollen                string `json:"grass_pollen,omitempty"`
+	MugwortPollen              string `json:"mugwort_pollen,omitempty"`
+	OlivePollen                string `json:"olive_pollen,omitempty"`
+	RagweedPollen              string `json:"ragweed_pollen,omitempty"`
+	EuropeanAQI                string `json:"european_aqi,omitempty"`
+	EuropeanAQIPM25            string `json:"european_aqi_pm2_5,omitempty"`
+	EuropeanAQIPM10            string `json:"european_aqi_pm10,omitempty"`
+	EuropeanAQINitrogenDioxide string `json:"european_aqi_nitrogen_dioxide,omitempty"`
+	EuropeanAQIOzone           string `json:"european_aqi_ozone,omitempty"`
+	EuropeanAQISulphurDioxide  string `json:"european_aqi_sulphur_dioxide,omitempty"`
+	USAQI                      string `json:"us_aqi,omitempty"`
+	USAQIPM25                  string `json:"us_aqi_pm2_5,omitempty"`
+	USAQIPM10                  string `json:"us_aqi_pm10,omitempty"`
+	USAQINitrogenDioxide       string `json:"us_aqi_nitrogen_dioxide,omitempty"`
+	USAQIOzone                 string `json:"us_aqi_ozone,omitempty"`
+	USAQISulphurDioxide        string `json:"us_aqi_sulphur_dioxide,omitempty"`
+	USAQICarbonMonoxide        string `json:"us_aqi_carbon_monoxide,omitempty"`
+}
+
+// rawAirQualityResponse represents the raw JSON response from the Air Quality API.
+type rawAirQualityResponse struct {
+	rawMeta
+
+	Current      json.RawMessage  `json:"current,omitempty"`
+	CurrentUnits *AirQualityUnits `json:"current_units,omitempty"`
+
+	Hourly      json.RawMessage  `json:"hourly,omitempty"`
+	HourlyUnits *AirQualityUnits `json:"hourly_units,omitempty"`
+}
+
+// rawAirQualityCurrent represents the raw current air quality data with time as string.
+type rawAirQualityCurrent struct {
+	Time string `json:"time"`
+	AirQualityCurrentData
+}
+
+// parseAirQualityResponse parses the Air Quality API response into an AirQuality struct.
+func parseAirQualityResponse(body []byte) (*AirQuality, error) {
+	var raw rawAirQualityResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	// Load timezone for proper time parsing
+	loc := raw.timeLocation()
+
+	aq := &AirQuality{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Elevation:            raw.Elevation,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+		CurrentUnits:         raw.CurrentUnits,
+		HourlyUnits:          raw.HourlyUnits,
+	}
+
+	// Parse current conditions
+	if len(raw.Current) > 0 {
+		var current rawAirQualityCurrent
+		if err := json.Unmarshal(raw.Current, &current); err != nil {
+			return nil, &DecodeError{Block: "current", Err: err}
+		}
+		t, err := parseDateTime(current.Time, loc)
+		if err != nil {
+			return nil, &DecodeError{Block: "current", Err: err}
+		}
+		current.AirQualityCurrentData.Time = t
+		aq.Current = &current.AirQualityCurrentData
+	}
+
+	// Parse hourly data
+	if len(raw.Hourly) > 0 {
+		hourly := &AirQualityHourlyData{}
+		times, err := parseTimeBlock(raw.Hourly, loc, hourly)
+		if err != nil {
+			return nil, &DecodeError{Block: "hourly", Err: err}
+		}
+		hourly.Times = times
+		aq.Hourly = hourly
+	}
+
+	return aq, nil
+}
+
+// AirQuality retrieves air quality data for the given request.
+func (c *Client) AirQuality(ctx context.Context, req *AirQualityRequest) (*AirQuality, error) {
+	url := req.buildURL(c.airQualityURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAirQualityResponse(body)
+}
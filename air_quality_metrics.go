@@ -0,0 +1,71 @@
+package omgo
+
+// AirQualityMetric represents a metric that can be requested from the Air Quality API.
+// The same metrics are available for hourly data and current conditions.
+type AirQualityMetric string
+
+// Air quality metrics available from the Open-Meteo Air Quality API.
+const (
+	// Particulate matter (μg/m³)
+	AirQualityPM10 AirQualityMetric = "pm10"
+	AirQualityPM25 AirQualityMetric = "pm2_5"
+
+	// Gases (μg/m³)
+	AirQualityCarbonMonoxide  AirQualityMetric = "carbon_monoxide"
+	AirQualityCarbonDioxide   AirQualityMetric = "carbon_dioxide"
+	AirQualityNitrogenDioxide AirQualityMetric = "nitrogen_dioxide"
+	AirQualitySulphurDioxide  AirQualityMetric = "sulphur_dioxide"
+	AirQualityOzone           AirQualityMetric = "ozone"
+	AirQualityAmmonia         AirQualityMetric = "ammonia"
+	AirQualityMethane         AirQualityMetric = "methane"
+
+	// Aerosols and dust
+	AirQualityAerosolOpticalDepth AirQualityMetric = "aerosol_optical_depth"
+	AirQualityDust                AirQualityMetric = "dust"
+
+	// UV index
+	AirQualityUVIndex         AirQualityMetric = "uv_index"
+	AirQualityUVIndexClearSky AirQualityMetric = "uv_index_clear_sky"
+
+	// Pollen (grains/m³, Europe only)
+	AirQualityAlderPollen   AirQualityMetric = "alder_pollen"
+	AirQualityBirchPollen   AirQualityMetric = "birch_pollen"
+	AirQualityGrassPollen   AirQualityMetric = "grass_pollen"
+	AirQualityMugwortPollen AirQualityMetric = "mugwort_pollen"
+	AirQualityOlivePollen   AirQualityMetric = "olive_pollen"
+	AirQualityRagweedPollen AirQualityMetric = "ragweed_pollen"
+
+	// European Air Quality Index
+	AirQualityEuropeanAQI                AirQualityMetric = "european_aqi"
+	AirQualityEuropeanAQIPM25            AirQualityMetric = "european_aqi_pm2_5"
+	AirQualityEuropeanAQIPM10            AirQualityMetric = "european_aqi_pm10"
+	AirQualityEuropeanAQINitrogenDioxide AirQualityMetric = "european_aqi_nitrogen_dioxide"
+	AirQualityEuropeanAQIOzone           AirQualityMetric = "european_aqi_ozone"
+	AirQualityEuropeanAQISulphurDioxide  AirQualityMetric = "european_aqi_sulphur_dioxide"
+
+	// United States Air Quality Index
+	AirQualityUSAQI                AirQualityMetric = "us_aqi"
+	AirQualityUSAQIPM25            AirQualityMetric = "us_aqi_pm2_5"
+	AirQualityUSAQIPM10            AirQualityMetric = "us_aqi_pm10"
+	AirQualityUSAQINitrogenDioxide AirQualityMetric = "us_aqi_nitrogen_dioxide"
+	AirQualityUSAQIOzone           AirQualityMetric = "us_aqi_ozone"
+	AirQualityUSAQISulphurDioxide  AirQualityMetric = "us_aqi_sulphur_dioxide"
+	AirQualityUSAQICarbonMonoxide  AirQualityMetric = "us_aqi_carbon_monoxide"
+)
+
+// String returns the API parameter string for the metric.
+func (m AirQualityMetric) String() string {
+	return string(m)
+}
+
+// AirQualityDomain selects the CAMS model domain used by the Air Quality API.
+type AirQualityDomain string
+
+const (
+	// AirQualityDomainAuto combines the European and global domains (API default).
+	AirQualityDomainAuto AirQualityDomain = "auto"
+	// AirQualityDomainEurope uses the 11 km CAMS European model only.
+	AirQualityDomainEurope AirQualityDomain = "cams_europe"
+	// AirQualityDomainGlobal uses the 40 km CAMS global model only.
+	AirQualityDomainGlobal AirQualityDomain = "cams_global"
+)
@@ -0,0 +1,93 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAirQualityRequestURL(t *testing.T) {
+	req, err := NewAirQualityRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	req.WithHourly(AirQualityPM25, AirQualityPM10, AirQualityPM25).
+		WithCurrent(AirQualityEuropeanAQI).
+		WithDomains(AirQualityDomainEurope).
+		WithTimezone("Europe/Berlin").
+		WithForecastDays(3).
+		WithCellSelection(CellSelectionNearest)
+
+	parsed, err := url.Parse(req.buildURL(airQualityBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "52.52", params.Get("latitude"))
+	assert.Equal(t, "13.41", params.Get("longitude"))
+	assert.Equal(t, "pm10,pm2_5", params.Get("hourly")) // sorted and deduplicated
+	assert.Equal(t, "european_aqi", params.Get("current"))
+	assert.Equal(t, "cams_europe", params.Get("domains"))
+	assert.Equal(t, "Europe/Berlin", params.Get("timezone"))
+	assert.Equal(t, "3", params.Get("forecast_days"))
+	assert.Equal(t, "nearest", params.Get("cell_selection"))
+}
+
+func TestParseAirQuality(t *testing.T) {
+	data, err := os.ReadFile("testdata/air_quality.json")
+	require.NoError(t, err)
+
+	aq, err := parseAirQualityResponse(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Europe/Berlin", aq.Timezone)
+
+	// Current
+	require.NotNil(t, aq.Current)
+	loc, _ := time.LoadLocation("Europe/Berlin")
+	assert.Equal(t, time.Date(2024, 1, 15, 14, 0, 0, 0, loc), aq.Current.Time)
+	assert.Equal(t, 3600, aq.Current.Interval)
+	require.NotNil(t, aq.Current.EuropeanAQI)
+	assert.Equal(t, 32.0, *aq.Current.EuropeanAQI)
+	assert.Nil(t, aq.Current.Ozone)
+	require.NotNil(t, aq.CurrentUnits)
+	assert.Equal(t, "EAQI", aq.CurrentUnits.EuropeanAQI)
+
+	// Hourly
+	require.NotNil(t, aq.Hourly)
+	assert.Len(t, aq.Hourly.Times, 3)
+	assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, loc), aq.Hourly.Times[0])
+	assert.Equal(t, 12.1, aq.Hourly.PM10[0])
+	assert.Equal(t, 8.9, aq.Hourly.PM25[0])
+	assert.True(t, aq.Hourly.Ozone.IsMissing(2))
+	assert.Equal(t, 3, aq.Hourly.BirchPollen.Missing())
+	assert.Equal(t, 37.0, aq.Hourly.USAQI[0])
+	require.NotNil(t, aq.HourlyUnits)
+	assert.Equal(t, "μg/m³", aq.HourlyUnits.PM25)
+	assert.Equal(t, "grains/m³", aq.HourlyUnits.BirchPollen)
+}
+
+func TestClientAirQuality(t *testing.T) {
+	data, err := os.ReadFile("testdata/air_quality.json")
+	require.NoError(t, err)
+
+	var requested string
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.String()
+		return newMockResponse(http.StatusOK, data), nil
+	})
+	client := NewClient(WithHTTPClient(mock), WithAirQualityURL("http://localhost/v1/air-quality"))
+
+	req, err := NewAirQualityRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(AirQualityPM10)
+
+	aq, err := client.AirQuality(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, aq.Hourly)
+	assert.Contains(t, requested, "http://localhost/v1/air-quality?")
+}
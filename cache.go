@@ -0,0 +1,252 @@
+package omgo
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Cache stores response bodies by key; see WithCache. Implementations must
+// be safe for concurrent use. Cache failures should not fail requests, so
+// a Cache that cannot read an entry reports a miss.
+type Cache interface {
+	// Get returns the body stored under key, if present and not expired.
+	Get(ctx context.Context, key string) ([]byte, bool)
+
+	// Set stores body under key for the given time to live.
+	Set(ctx context.Context, key string, body []byte, ttl time.Duration)
+}
+
+// CacheTTL sets how long responses are cached. Archived data changes rarely
+// and can be kept longer than forecasts: responses of the Climate and
+// Elevation APIs, and responses of any other API for a date range ending
+// before today (UTC). A zero TTL disables caching for that kind of response.
+type CacheTTL struct {
+	Forecast time.Duration
+	Archive  time.Duration
+}
+
+// DefaultCacheTTL returns TTLs of 15 minutes for forecasts and 24 hours
+// for archived data.
+func DefaultCacheTTL() CacheTTL {
+	return CacheTTL{Forecast: 15 * time.Minute, Archive: 24 * time.Hour}
+}
+
+// CacheStats counts the cache lookups of a Client.
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// clientCache is the cache configuration and counters of a Client.
+type clientCache struct {
+	store  Cache
+	ttl    CacheTTL
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// WithCache caches successful responses in cache, keyed by the request URL
+// without the API key. Lookups are counted in Client.CacheStats.
+func WithCache(cache Cache, ttl CacheTTL) Option {
+	return func(c *Client) {
+		c.cache = &clientCache{store: cache, ttl: ttl}
+	}
+}
+
+// CacheStats returns the number of cache hits and misses so far. It
+// returns zero stats if the client has no cache.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return CacheStats{Hits: c.cache.hits.Load(), Misses: c.cache.misses.Load()}
+}
+
+// cacheClass is the kind of data of a response, which selects its CacheTTL.
+type cacheClass int
+
+const (
+	cacheForecast cacheClass = iota
+	cacheArchive
+)
+
+// pastDateClass returns cacheArchive if endDate (yyyy-mm-dd) is before
+// today in UTC, and cacheForecast otherwise.
+func pastDateClass(endDate string, now time.Time) cacheClass {
+	end, err := time.Parse(timeLayoutDate, endDate)
+	if err != nil {
+		return cacheForecast
+	}
+	y, m, d := now.UTC().Date()
+	if end.Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) {
+		return cacheArchive
+	}
+	return cacheForecast
+}
+
+// cacheTTL returns the time to live of a response of the given class.
+func (c *Client) cacheTTL(class cacheClass) time.Duration {
+	if class == cacheArchive {
+		return c.cache.ttl.Archive
+	}
+	return c.cache.ttl.Forecast
+}
+
+// cacheKey returns the canonical form of a request URL, without the API key,
+// so requests differing only in key or parameter order share an entry.
+func cacheKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Del("apikey")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// memoryEntry is an entry of a MemoryCache.
+type memoryEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entry when full.
+type MemoryCache struct {
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // front is most recently used
+}
+
+// NewMemoryCache creates a MemoryCache holding up to maxEntries responses.
+// A maxEntries of zero or less makes the cache unbounded; entries are then
+// only removed when they are found expired.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*memoryEntry)
+	if !m.now().Before(e.expires) {
+		m.lru.Remove(el)
+		delete(m.entries, key)
+		return nil, false
+	}
+	m.lru.MoveToFront(el)
+	return e.body, true
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(_ context.Context, key string, body []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := m.now().Add(ttl)
+	if el, ok := m.entries[key]; ok {
+		e := el.Value.(*memoryEntry)
+		e.body, e.expires = body, expires
+		m.lru.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.lru.PushFront(&memoryEntry{key: key, body: body, expires: expires})
+	for m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryEntry).key)
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet evicted.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// FileCache is a Cache storing one file per response in a directory. It can
+// be shared by several processes.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache creates a FileCache in dir, creating the directory if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating cache directory: %w", err)
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// path returns the file of a key.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements Cache. Files start with the expiry time in Unix
+// nanoseconds, followed by the body.
+func (f *FileCache) Get(_ context.Context, key string) ([]byte, bool) {
+	path := f.path(key)
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(data)))
+	if !f.now().Before(expires) {
+		os.Remove(path)
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Set implements Cache. The file is written atomically; write errors are
+// ignored, leaving the response uncached.
+func (f *FileCache) Set(_ context.Context, key string, body []byte, ttl time.Duration) {
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	var header [8]byte
+	binary.BigEndian.PutUint64(header[:], uint64(f.now().Add(ttl).UnixNano()))
+	_, err = tmp.Write(header[:])
+	if err == nil {
+		_, err = tmp.Write(body)
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		os.Rename(tmp.Name(), f.path(key))
+	}
+}
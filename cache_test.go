@@ -0,0 +1,205 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKey(t *testing.T) {
+	a := cacheKey("https://api.open-meteo.com/v1/forecast?longitude=13.41&latitude=52.52&apikey=secret")
+	b := cacheKey("https://api.open-meteo.com/v1/forecast?latitude=52.52&longitude=13.41")
+	assert.Equal(t, b, a)
+	assert.NotContains(t, a, "secret")
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set(ctx, "a", []byte("1"), time.Minute)
+	cache.Set(ctx, "b", []byte("2"), time.Hour)
+
+	body, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+	assert.Equal(t, []byte("1"), body)
+
+	// "b" is least recently used and evicted
+	cache.Set(ctx, "c", []byte("3"), time.Hour)
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.Get(ctx, "b")
+	assert.False(t, ok)
+
+	// Expired entries are dropped
+	now = now.Add(time.Minute)
+	_, ok = cache.Get(ctx, "a")
+	assert.False(t, ok)
+	_, ok = cache.Get(ctx, "c")
+	assert.True(t, ok)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestFileCache(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewFileCache(t.TempDir())
+	require.NoError(t, err)
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Get(ctx, "a")
+	assert.False(t, ok)
+
+	cache.Set(ctx, "a", []byte(`{"latitude": 52.52}`), time.Minute)
+	body, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+	assert.Equal(t, `{"latitude": 52.52}`, string(body))
+
+	// Entries are shared between instances
+	other, err := NewFileCache(cache.dir)
+	require.NoError(t, err)
+	other.now = cache.now
+	_, ok = other.Get(ctx, "a")
+	assert.True(t, ok)
+
+	// Expired entries are removed
+	now = now.Add(time.Minute)
+	_, ok = cache.Get(ctx, "a")
+	assert.False(t, ok)
+	entries, err := os.ReadDir(cache.dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestClientCache(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	requests := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return newMockResponse(http.StatusOK, data), nil
+	})
+
+	cache := NewMemoryCache(10)
+	client := NewClient(WithHTTPClient(mock), WithCache(cache, DefaultCacheTTL()), WithAPIKey("secret"))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	for i := 0; i < 3; i++ {
+		weather, err := client.Forecast(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, weather.Hourly)
+	}
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1}, client.CacheStats())
+
+	// Errors are not cached
+	failing := NewClient(WithHTTPClient(&mockHTTPClient{response: newMockResponse(http.StatusBadGateway, []byte("bad gateway"))}),
+		WithCache(NewMemoryCache(10), DefaultCacheTTL()))
+	_, err = failing.Forecast(context.Background(), req)
+	require.Error(t, err)
+	_, err = failing.Forecast(context.Background(), req)
+	require.Error(t, err)
+	assert.Equal(t, CacheStats{Misses: 2}, failing.CacheStats())
+}
+
+func TestClientCacheTTL(t *testing.T) {
+	requests := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return newMockResponse(http.StatusOK, []byte(`{}`)), nil
+	})
+	// Only archived data is cached
+	client := NewClient(WithHTTPClient(mock), WithCache(NewMemoryCache(10), CacheTTL{Archive: time.Hour}))
+	ctx := context.Background()
+
+	forecast, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	historicalForecast, err := NewHistoricalForecastRequest(52.52, 13.41, "2023-01-01", "2023-01-31")
+	require.NoError(t, err)
+	historical, err := NewHistoricalRequest(52.52, 13.41, "2023-01-01", "2023-01-31")
+	require.NoError(t, err)
+	climate, err := NewClimateRequest(52.52, 13.41, "1950-01-01", "2050-12-31", ClimateCMCCCM2VHR4)
+	require.NoError(t, err)
+	pastRuns, err := NewPreviousRunsRequest(52.52, 13.41)
+	require.NoError(t, err)
+	pastRuns.WithDateRange("2023-01-01", "2023-01-31")
+	recentRuns, err := NewPreviousRunsRequest(52.52, 13.41)
+	require.NoError(t, err)
+	recentRuns.WithPastDays(7)
+	today := time.Now().UTC().Format(timeLayoutDate)
+	recentHistoricalForecast, err := NewHistoricalForecastRequest(52.52, 13.41, "2023-01-01", today)
+	require.NoError(t, err)
+	pastForecast, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	pastForecast.WithDateRange("2023-01-01", "2023-01-31")
+	pastFlood, err := NewFloodRequest(52.52, 13.41)
+	require.NoError(t, err)
+	pastFlood.WithDateRange("2023-01-01", "2023-01-31")
+	recentFlood, err := NewFloodRequest(52.52, 13.41)
+	require.NoError(t, err)
+	pastMarine, err := NewMarineRequest(54.54, 10.23)
+	require.NoError(t, err)
+	pastMarine.WithDateRange("2023-01-01", "2023-01-31")
+	pastAirQuality, err := NewAirQualityRequest(52.52, 13.41)
+	require.NoError(t, err)
+	pastAirQuality.WithDateRange("2023-01-01", "2023-01-31")
+
+	calls := []struct {
+		name   string
+		call   func() error
+		cached bool
+	}{
+		{"forecast", func() error { _, err := client.Forecast(ctx, forecast); return err }, false},
+		{"historical", func() error { _, err := client.Historical(ctx, historical); return err }, true},
+		{"historical forecast", func() error { _, err := client.HistoricalForecast(ctx, historicalForecast); return err }, true},
+		{"climate", func() error { _, err := client.Climate(ctx, climate); return err }, true},
+		{"past previous runs", func() error { _, err := client.PreviousRuns(ctx, pastRuns); return err }, true},
+		{"recent previous runs", func() error { _, err := client.PreviousRuns(ctx, recentRuns); return err }, false},
+		{"recent historical forecast", func() error { _, err := client.HistoricalForecast(ctx, recentHistoricalForecast); return err }, false},
+		{"past forecast", func() error { _, err := client.Forecast(ctx, pastForecast); return err }, true},
+		{"past flood", func() error { _, err := client.Flood(ctx, pastFlood); return err }, true},
+		{"recent flood", func() error { _, err := client.Flood(ctx, recentFlood); return err }, false},
+		{"past marine", func() error { _, err := client.Marine(ctx, pastMarine); return err }, true},
+		{"past air quality", func() error { _, err := client.AirQuality(ctx, pastAirQuality); return err }, true},
+	}
+	for _, c := range calls {
+		requests = 0
+		require.NoError(t, c.call(), c.name)
+		require.NoError(t, c.call(), c.name)
+		if c.cached {
+			assert.Equal(t, 1, requests, c.name)
+		} else {
+			assert.Equal(t, 2, requests, c.name)
+		}
+	}
+
+	// Without a cache
+	assert.Equal(t, CacheStats{}, NewClient().CacheStats())
+}
+
+func TestPastDateClass(t *testing.T) {
+	now := time.Date(2024, 1, 15, 0, 30, 0, 0, time.FixedZone("CET", 3600))
+	assert.Equal(t, cacheArchive, pastDateClass("2024-01-13", now))
+	assert.Equal(t, cacheForecast, pastDateClass("2024-01-14", now), "today in UTC")
+	assert.Equal(t, cacheForecast, pastDateClass("2024-02-01", now))
+	assert.Equal(t, cacheForecast, pastDateClass("", now))
+}
+
+func TestMemoryCacheUnbounded(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCache(0)
+	for _, key := range []string{"a", "b", "c"} {
+		cache.Set(ctx, key, []byte(key), time.Hour)
+	}
+	assert.Equal(t, 3, cache.Len())
+}
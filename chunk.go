@@ -0,0 +1,260 @@
+package omgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ChunkOptions configures Client.HistoricalChunked.
+type ChunkOptions struct {
+	// Days is the number of days per chunk. Defaults to 365.
+	Days int
+
+	// Concurrency is the maximum number of chunks fetched at once. Defaults to 4.
+	Concurrency int
+}
+
+// Chunks splits the request into requests covering consecutive date ranges
+// of at most days days each. All other options are shared.
+func (r *HistoricalRequest) Chunks(days int) ([]*HistoricalRequest, error) {
+	if days < 1 {
+		return nil, fmt.Errorf("chunk size must be at least 1 day, got %d", days)
+	}
+	start, err := time.Parse(timeLayoutDate, r.startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid startDate %q: %w", r.startDate, err)
+	}
+	end, err := time.Parse(timeLayoutDate, r.endDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endDate %q: %w", r.endDate, err)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("endDate %s is before startDate %s", r.endDate, r.startDate)
+	}
+
+	var chunks []*HistoricalRequest
+	for from := start; !from.After(end); from = from.AddDate(0, 0, days) {
+		to := from.AddDate(0, 0, days-1)
+		if to.After(end) {
+			to = end
+		}
+		chunk := *r
+		chunk.locations = slices.Clone(r.locations)
+		chunk.hourlyMetrics = slices.Clone(r.hourlyMetrics)
+		chunk.dailyMetrics = slices.Clone(r.dailyMetrics)
+		chunk.startDate = from.Format(timeLayoutDate)
+		chunk.endDate = to.Format(timeLayoutDate)
+		chunks = append(chunks, &chunk)
+	}
+	return chunks, nil
+}
+
+// HistoricalChunked retrieves historical weather data for a long date range
+// by splitting the request into chunks (see HistoricalRequest.Chunks),
+// fetching them concurrently and joining the hourly and daily data into one
+// Weather. Overlapping time steps at chunk boundaries are dropped; a gap
+// between chunks is an error. The first failing chunk cancels the others.
+func (c *Client) HistoricalChunked(ctx context.Context, req *HistoricalRequest, opts ChunkOptions) (*Weather, error) {
+	if len(req.locations) > 1 {
+		return nil, fmt.Errorf("request has %d locations, chunked requests support one", len(req.locations))
+	}
+	days := opts.Days
+	if days == 0 {
+		days = 365
+	}
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 4
+	}
+	chunks, err := req.Chunks(days)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*Weather, len(chunks))
+	errs := make([]error, len(chunks))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk *HistoricalRequest) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			results[i], errs[i] = c.Historical(ctx, chunk)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	// Report the first chunk that failed on its own, not one canceled by it
+	var failed error
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		err = fmt.Errorf("chunk %s to %s: %w", chunks[i].startDate, chunks[i].endDate, err)
+		if !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if failed == nil {
+			failed = err
+		}
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	return joinWeather(results)
+}
+
+// joinWeather joins the hourly and daily data of consecutive chunks into
+// the first chunk's Weather.
+func joinWeather(chunks []*Weather) (*Weather, error) {
+	joined := chunks[0]
+	for _, w := range chunks[1:] {
+		if err := joinBlock(&joined.Hourly, w.Hourly, nextStep); err != nil {
+			return nil, fmt.Errorf("joining hourly data: %w", err)
+		}
+		if err := joinBlock(&joined.Daily, w.Daily, nextDay); err != nil {
+			return nil, fmt.Errorf("joining daily data: %w", err)
+		}
+		if joined.HourlyUnits == nil {
+			joined.HourlyUnits = w.HourlyUnits
+		}
+		if joined.DailyUnits == nil {
+			joined.DailyUnits = w.DailyUnits
+		}
+	}
+	return joined, nil
+}
+
+// nextStep returns the time step following the last time of dst, at the
+// interval of the block: the shortest interval between consecutive instants
+// of dst or src, or an hour if neither has two time steps. Instants are
+// used so a DST change next to the chunk boundary is not a gap or overlap.
+func nextStep(dst, src []time.Time) time.Time {
+	var step time.Duration
+	for _, times := range [][]time.Time{dst, src} {
+		for i := 1; i < len(times); i++ {
+			if d := times[i].Sub(times[i-1]); d > 0 && (step == 0 || d < step) {
+				step = d
+			}
+		}
+	}
+	if step == 0 {
+		step = time.Hour
+	}
+	return dst[len(dst)-1].Add(step)
+}
+
+// nextDay returns the day following the last date of dst. Dates are local
+// midnights, so the following one is a calendar day later rather than 24
+// hours across a DST change.
+func nextDay(dst, _ []time.Time) time.Time {
+	return dst[len(dst)-1].AddDate(0, 0, 1)
+}
+
+// timeSeries is a data block with a time axis.
+type timeSeries interface {
+	*HourlyData | *DailyData
+	times() []time.Time
+}
+
+func (h *HourlyData) times() []time.Time { return h.Times }
+func (d *DailyData) times() []time.Time  { return d.Times }
+
+// joinBlock appends the time steps of src after the last time step of
+// *dst, dropping steps of src that overlap. It returns an error if src
+// doesn't continue where *dst ends.
+func joinBlock[T timeSeries](dst *T, src T, next func(dst, src []time.Time) time.Time) error {
+	if src == nil || len(src.times()) == 0 {
+		return nil
+	}
+	if *dst == nil || len((*dst).times()) == 0 {
+		*dst = src
+		return nil
+	}
+
+	dstTimes, srcTimes := (*dst).times(), src.times()
+	last := dstTimes[len(dstTimes)-1]
+	skip := 0
+	for skip < len(srcTimes) && !srcTimes[skip].After(last) {
+		skip++
+	}
+	if skip == len(srcTimes) {
+		return nil
+	}
+	if want := next(dstTimes, srcTimes); !srcTimes[skip].Equal(want) {
+		return fmt.Errorf("gap between %s and %s", last.Format(timeLayoutDateTime), srcTimes[skip].Format(timeLayoutDateTime))
+	}
+
+	appendFields(reflect.ValueOf(*dst).Elem(), reflect.ValueOf(src).Elem(), len(dstTimes), len(srcTimes), skip)
+	return nil
+}
+
+// appendFields appends the slice fields and Extra series of the struct src
+// to those of dst, skipping the first skip elements. Variables present in
+// only one of them are padded with missing values so all stay aligned.
+func appendFields(dst, src reflect.Value, dstLen, srcLen, skip int) {
+	for _, f := range reflect.VisibleFields(dst.Type()) {
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+		d, s := dst.FieldByIndex(f.Index), src.FieldByIndex(f.Index)
+		switch f.Type.Kind() {
+		case reflect.Slice:
+			if d.IsNil() && s.IsNil() {
+				continue
+			}
+			d.Set(reflect.AppendSlice(padded(d, f.Type, dstLen), padded(s, f.Type, srcLen).Slice(skip, srcLen)))
+		case reflect.Map:
+			if d.IsNil() && s.IsNil() {
+				continue
+			}
+			joined := reflect.MakeMap(f.Type)
+			for _, m := range []reflect.Value{d, s} {
+				for _, key := range m.MapKeys() {
+					if joined.MapIndex(key).IsValid() {
+						continue
+					}
+					joined.SetMapIndex(key, reflect.AppendSlice(
+						padded(d.MapIndex(key), f.Type.Elem(), dstLen),
+						padded(s.MapIndex(key), f.Type.Elem(), srcLen).Slice(skip, srcLen),
+					))
+				}
+			}
+			d.Set(joined)
+		}
+	}
+}
+
+// padded returns the slice v, or n missing values of type typ if v is
+// invalid or nil. Missing floats are NaN; other types use their zero value.
+func padded(v reflect.Value, typ reflect.Type, n int) reflect.Value {
+	if v.IsValid() && !v.IsNil() {
+		return v
+	}
+	s := reflect.MakeSlice(typ, n, n)
+	if typ.Elem().Kind() == reflect.Float64 {
+		for i := 0; i < n; i++ {
+			s.Index(i).SetFloat(math.NaN())
+		}
+	}
+	return s
+}
@@ -0,0 +1,209 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalRequestChunks(t *testing.T) {
+	req, err := NewHistoricalRequest(52.52, 13.41, "2020-01-01", "2020-03-15")
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	chunks, err := req.Chunks(30)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, "2020-01-01", chunks[0].startDate)
+	assert.Equal(t, "2020-01-30", chunks[0].endDate)
+	assert.Equal(t, "2020-01-31", chunks[1].startDate)
+	assert.Equal(t, "2020-02-29", chunks[1].endDate)
+	assert.Equal(t, "2020-03-01", chunks[2].startDate)
+	assert.Equal(t, "2020-03-15", chunks[2].endDate)
+	assert.Equal(t, []HourlyMetric{HourlyTemperature2m}, chunks[2].hourlyMetrics)
+
+	// Chunks don't share metrics with each other or the request, even
+	// when the request's slices have spare capacity
+	req.WithHourly(HourlyPrecipitation).WithHourly(HourlyRain)
+	chunks, err = req.Chunks(30)
+	require.NoError(t, err)
+	chunks[0].WithHourly(HourlySnowfall)
+	chunks[1].WithHourly(HourlyCloudCover)
+	assert.Equal(t, []HourlyMetric{HourlyTemperature2m, HourlyPrecipitation, HourlyRain, HourlySnowfall}, chunks[0].hourlyMetrics)
+	assert.Equal(t, []HourlyMetric{HourlyTemperature2m, HourlyPrecipitation, HourlyRain}, chunks[2].hourlyMetrics)
+	assert.Equal(t, []HourlyMetric{HourlyTemperature2m, HourlyPrecipitation, HourlyRain}, req.hourlyMetrics)
+
+	_, err = req.Chunks(0)
+	assert.Error(t, err)
+
+	req, err = NewHistoricalRequest(52.52, 13.41, "2020-03-15", "2020-01-01")
+	require.NoError(t, err)
+	_, err = req.Chunks(30)
+	assert.Error(t, err)
+}
+
+// chunkResponse builds an archive response covering the requested dates.
+// Hourly temperatures count the hours since 2020-01-01; overlap prepends
+// the last hour of the previous day, gap drops the first hour.
+func chunkResponse(t *testing.T, req *http.Request, overlap, gap bool) []byte {
+	t.Helper()
+	q := req.URL.Query()
+	start, err := time.Parse(timeLayoutDate, q.Get("start_date"))
+	require.NoError(t, err)
+	end, err := time.Parse(timeLayoutDate, q.Get("end_date"))
+	require.NoError(t, err)
+	origin := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	from, to := start, end.AddDate(0, 0, 1)
+	if overlap && !start.Equal(origin) {
+		from = from.Add(-time.Hour)
+	}
+	if gap && !start.Equal(origin) {
+		from = from.Add(time.Hour)
+	}
+
+	var hourTimes []string
+	var temps []float64
+	for h := from; h.Before(to); h = h.Add(time.Hour) {
+		hourTimes = append(hourTimes, h.Format(timeLayoutDateTime))
+		temps = append(temps, h.Sub(origin).Hours())
+	}
+	var dayTimes []string
+	var maxTemps []float64
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		dayTimes = append(dayTimes, d.Format(timeLayoutDate))
+		maxTemps = append(maxTemps, d.Sub(origin).Hours()/24)
+	}
+
+	hourly := map[string]any{"time": hourTimes, "temperature_2m": temps}
+	if !start.Equal(origin) {
+		// A variable only returned for later chunks
+		hourly["boundary_layer_height"] = temps
+	}
+	body, err := json.Marshal(map[string]any{
+		"latitude": 52.52, "longitude": 13.42, "timezone": "GMT",
+		"hourly":       hourly,
+		"hourly_units": map[string]string{"temperature_2m": "°C"},
+		"daily":        map[string]any{"time": dayTimes, "temperature_2m_max": maxTemps},
+	})
+	require.NoError(t, err)
+	return body
+}
+
+func TestClientHistoricalChunked(t *testing.T) {
+	var requests atomic.Int32
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requests.Add(1)
+		return newMockResponse(http.StatusOK, chunkResponse(t, req, true, false)), nil
+	})
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewHistoricalRequest(52.52, 13.41, "2020-01-01", "2020-01-10")
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m).WithDaily(DailyTemperature2mMax)
+
+	weather, err := client.HistoricalChunked(context.Background(), req, ChunkOptions{Days: 3, Concurrency: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), requests.Load())
+	assert.Equal(t, "°C", weather.HourlyUnits.Temperature2m)
+
+	// Continuous hourly data, with overlapping hours dropped
+	require.Len(t, weather.Hourly.Times, 240)
+	require.Len(t, weather.Hourly.Temperature2m, 240)
+	for i, ts := range weather.Hourly.Times {
+		assert.Equal(t, time.Date(2020, 1, 1, i, 0, 0, 0, time.UTC), ts.UTC())
+		assert.Equal(t, float64(i), weather.Hourly.Temperature2m[i])
+	}
+
+	// Variables missing from a chunk are padded
+	blh := weather.Hourly.Extra["boundary_layer_height"]
+	require.Len(t, blh, 240)
+	assert.True(t, math.IsNaN(blh[0]))
+	assert.True(t, blh.IsMissing(71))
+	assert.Equal(t, 72.0, blh[72])
+
+	require.Len(t, weather.Daily.Times, 10)
+	assert.Equal(t, Series{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, weather.Daily.Temperature2mMax)
+}
+
+func TestClientHistoricalChunkedErrors(t *testing.T) {
+	req, err := NewHistoricalRequest(52.52, 13.41, "2020-01-01", "2020-01-10")
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	// Gap between chunks
+	gaps := NewClient(WithHTTPClient(mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		return newMockResponse(http.StatusOK, chunkResponse(t, req, false, true)), nil
+	})))
+	_, err = gaps.HistoricalChunked(context.Background(), req, ChunkOptions{Days: 5})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gap between 2020-01-05T23:00 and 2020-01-06T01:00")
+
+	// A failing chunk
+	failing := NewClient(WithHTTPClient(mockHTTPFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Query().Get("start_date") == "2020-01-06" {
+			return newMockResponse(http.StatusBadRequest, []byte(`{"error": true, "reason": "Invalid"}`)), nil
+		}
+		return newMockResponse(http.StatusOK, chunkResponse(t, r, false, false)), nil
+	})))
+	_, err = failing.HistoricalChunked(context.Background(), req, ChunkOptions{Days: 5})
+	require.ErrorIs(t, err, ErrInvalidParameter)
+	assert.Contains(t, err.Error(), "chunk 2020-01-06 to 2020-01-10")
+
+	// Multiple locations
+	multi, err := NewHistoricalRequestForLocations([]Location{{Latitude: 52.52, Longitude: 13.41}, {Latitude: 48.85, Longitude: 2.35}}, "2020-01-01", "2020-01-10")
+	require.NoError(t, err)
+	_, err = failing.HistoricalChunked(context.Background(), multi, ChunkOptions{})
+	assert.Error(t, err)
+}
+
+func TestJoinBlockDST(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+	at := func(ts ...string) []time.Time {
+		times := make([]time.Time, len(ts))
+		for i, s := range ts {
+			u, err := time.Parse(time.RFC3339, s)
+			require.NoError(t, err)
+			times[i] = u.In(amsterdam)
+		}
+		return times
+	}
+	hourly := func(times []time.Time) *HourlyData {
+		temps := make(Series, len(times))
+		return &HourlyData{BaseMetrics: BaseMetrics{Times: times, Temperature2m: temps}}
+	}
+
+	// Clocks go back from 03:00 CEST to 02:00 CET at the boundary: the
+	// wall-clock hour repeats but the instants are continuous
+	joined := hourly(at("2020-10-24T23:00:00Z", "2020-10-25T00:00:00Z"))
+	require.NoError(t, joinBlock(&joined, hourly(at("2020-10-25T01:00:00Z", "2020-10-25T02:00:00Z")), nextStep))
+	require.Len(t, joined.Times, 4)
+	assert.Equal(t, "02:00 CEST", joined.Times[1].Format("15:04 MST"))
+	assert.Equal(t, "02:00 CET", joined.Times[2].Format("15:04 MST"))
+	assert.Len(t, joined.Temperature2m, 4)
+
+	// Clocks go forward from 02:00 CET to 03:00 CEST at the boundary
+	joined = hourly(at("2020-03-29T00:00:00Z"))
+	require.NoError(t, joinBlock(&joined, hourly(at("2020-03-29T01:00:00Z")), nextStep))
+	assert.Len(t, joined.Times, 2)
+
+	// The step is taken from the data, not assumed to be an hour
+	joined = hourly(at("2020-10-25T00:30:00Z", "2020-10-25T00:45:00Z"))
+	require.NoError(t, joinBlock(&joined, hourly(at("2020-10-25T01:00:00Z")), nextStep))
+	assert.Len(t, joined.Times, 3)
+	err = joinBlock(&joined, hourly(at("2020-10-25T01:30:00Z")), nextStep)
+	assert.ErrorContains(t, err, "gap")
+
+	// Local midnights are a day apart across the change
+	daily := &DailyData{Times: at("2020-10-23T22:00:00Z")}
+	require.NoError(t, joinBlock(&daily, &DailyData{Times: at("2020-10-24T22:00:00Z", "2020-10-25T23:00:00Z")}, nextDay))
+	assert.Len(t, daily.Times, 3)
+}
@@ -17,11 +17,26 @@ type HTTPClient interface {
 
 // Client is the Open-Meteo API client.
 type Client struct {
-	forecastURL   string
-	historicalURL string
-	httpClient    HTTPClient
-	userAgent     string
-	apiKey        string
+	forecastURL           string
+	historicalURL         string
+	geocodingURL          string
+	elevationURL          string
+	airQualityURL         string
+	marineURL             string
+	floodURL              string
+	ensembleURL           string
+	climateURL            string
+	seasonalURL           string
+	historicalForecastURL string
+	previousRunsURL       string
+	satelliteURL          string
+	httpClient            HTTPClient
+	userAgent             string
+	apiKey                string
+	retry                 *RetryPolicy
+	limiter               *RateLimiter
+	cache                 *clientCache
+	flights               flightGroup
 }
 
 // Option is a functional option for configuring the Client.
@@ -42,10 +57,21 @@ var defaultHTTPClient = &http.Client{
 // NewClient creates a new Open-Meteo API client.
 func NewClient(opts ...Option) *Client {
 	c := &Client{
-		forecastURL:   forecastBaseURL,
-		historicalURL: historicalBaseURL,
-		httpClient:    defaultHTTPClient,
-		userAgent:     DefaultUserAgent,
+		forecastURL:           forecastBaseURL,
+		historicalURL:         historicalBaseURL,
+		geocodingURL:          geocodingBaseURL,
+		elevationURL:          elevationBaseURL,
+		airQualityURL:         airQualityBaseURL,
+		marineURL:             marineBaseURL,
+		floodURL:              floodBaseURL,
+		ensembleURL:           ensembleBaseURL,
+		climateURL:            climateBaseURL,
+		seasonalURL:           seasonalBaseURL,
+		historicalForecastURL: historicalForecastBaseURL,
+		previousRunsURL:       previousRunsBaseURL,
+		satelliteURL:          satelliteBaseURL,
+		httpClient:            defaultHTTPClient,
+		userAgent:             DefaultUserAgent,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -67,6 +93,83 @@ func WithHistoricalURL(url string) Option {
 	}
 }
 
+// WithGeocodingURL sets a custom base URL for the Geocoding API.
+func WithGeocodingURL(url string) Option {
+	return func(c *Client) {
+		c.geocodingURL = url
+	}
+}
+
+// WithElevationURL sets a custom base URL for the Elevation API.
+func WithElevationURL(url string) Option {
+	return func(c *Client) {
+		c.elevationURL = url
+	}
+}
+
+// WithAirQualityURL sets a custom base URL for the Air Quality API.
+func WithAirQualityURL(url string) Option {
+	return func(c *Client) {
+		c.airQualityURL = url
+	}
+}
+
+// WithMarineURL sets a custom base URL for the Marine API.
+func WithMarineURL(url string) Option {
+	return func(c *Client) {
+		c.marineURL = url
+	}
+}
+
+// WithFloodURL sets a custom base URL for the Flood API.
+func WithFloodURL(url string) Option {
+	return func(c *Client) {
+		c.floodURL = url
+	}
+}
+
+// WithEnsembleURL sets a custom base URL for the Ensemble API.
+func WithEnsembleURL(url string) Option {
+	return func(c *Client) {
+		c.ensembleURL = url
+	}
+}
+
+// WithClimateURL sets a custom base URL for the Climate API.
+func WithClimateURL(url string) Option {
+	return func(c *Client) {
+		c.climateURL = url
+	}
+}
+
+// WithSeasonalURL sets a custom base URL for the Seasonal Forecast API.
+func WithSeasonalURL(url string) Option {
+	return func(c *Client) {
+		c.seasonalURL = url
+	}
+}
+
+// WithHistoricalForecastURL sets a custom base URL for the Historical Forecast API.
+func WithHistoricalForecastURL(url string) Option {
+	return func(c *Client) {
+		c.historicalForecastURL = url
+	}
+}
+
+// WithPreviousRunsURL sets a custom base URL for the Previous Runs API.
+func WithPreviousRunsURL(url string) Option {
+	return func(c *Client) {
+		c.previousRunsURL = url
+	}
+}
+
+// WithSatelliteURL sets a custom base URL for the Satellite Radiation API.
+func WithSatelliteURL(url string) Option {
+	return func(c *Client) {
+		c.satelliteURL = url
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client.
 func WithHTTPClient(hc HTTPClient) Option {
 	return func(c *Client) {
@@ -91,31 +194,175 @@ func WithAPIKey(key string) Option {
 }
 
 // Forecast retrieves weather forecast data for the given request.
+// Requests covering several locations must use ForecastMulti instead.
 func (c *Client) Forecast(ctx context.Context, req *ForecastRequest) (*Weather, error) {
+	if len(req.locations) > 1 {
+		return nil, fmt.Errorf("request has %d locations, use ForecastMulti", len(req.locations))
+	}
 	url := req.buildURL(c.forecastURL, c.apiKey)
 
-	body, err := c.doRequest(ctx, url)
-	if err != nil {
-		return nil, err
-	}
+	return c.fetchWeather(ctx, url, pastDateClass(req.endDate, time.Now()), func(body []byte) (*Weather, error) {
+		return decodeWeather(body, req.format, req.variables(), req.models)
+	})
+}
 
-	return parseWeatherResponse(body)
+// ForecastMulti retrieves weather forecast data for every location in the request
+// with a single API call. The result contains one Weather per location, in the
+// same order as the request's locations.
+func (c *Client) ForecastMulti(ctx context.Context, req *ForecastRequest) ([]*Weather, error) {
+	url := req.buildURL(c.forecastURL, c.apiKey)
+
+	return c.fetchMultiWeather(ctx, url, pastDateClass(req.endDate, time.Now()), func(body []byte) ([]*Weather, error) {
+		return decodeMultiWeather(body, req.format, req.variables(), len(req.locations), req.models)
+	})
 }
 
 // Historical retrieves historical weather data for the given request.
+// Requests covering several locations must use HistoricalMulti instead.
 func (c *Client) Historical(ctx context.Context, req *HistoricalRequest) (*Weather, error) {
+	if len(req.locations) > 1 {
+		return nil, fmt.Errorf("request has %d locations, use HistoricalMulti", len(req.locations))
+	}
+	url := req.buildURL(c.historicalURL, c.apiKey)
+
+	return c.fetchWeather(ctx, url, pastDateClass(req.endDate, time.Now()), func(body []byte) (*Weather, error) {
+		return decodeWeather(body, req.format, req.variables(), nil)
+	})
+}
+
+// HistoricalMulti retrieves historical weather data for every location in the request
+// with a single API call. The result contains one Weather per location, in the
+// same order as the request's locations.
+func (c *Client) HistoricalMulti(ctx context.Context, req *HistoricalRequest) ([]*Weather, error) {
 	url := req.buildURL(c.historicalURL, c.apiKey)
 
-	body, err := c.doRequest(ctx, url)
+	return c.fetchMultiWeather(ctx, url, pastDateClass(req.endDate, time.Now()), func(body []byte) ([]*Weather, error) {
+		return decodeMultiWeather(body, req.format, req.variables(), len(req.locations), nil)
+	})
+}
+
+// HistoricalForecast retrieves archived forecast model output for the given request.
+// Use NewHistoricalForecastRequest to create a request with the required date range.
+// Requests covering several locations must use HistoricalForecastMulti instead.
+func (c *Client) HistoricalForecast(ctx context.Context, req *ForecastRequest) (*Weather, error) {
+	if len(req.locations) > 1 {
+		return nil, fmt.Errorf("request has %d locations, use HistoricalForecastMulti", len(req.locations))
+	}
+	url := req.buildURL(c.historicalForecastURL, c.apiKey)
+
+	return c.fetchWeather(ctx, url, pastDateClass(req.endDate, time.Now()), func(body []byte) (*Weather, error) {
+		return decodeWeather(body, req.format, req.variables(), req.models)
+	})
+}
+
+// HistoricalForecastMulti retrieves archived forecast model output for every location
+// in the request with a single API call. The result contains one Weather per location,
+// in the same order as the request's locations.
+func (c *Client) HistoricalForecastMulti(ctx context.Context, req *ForecastRequest) ([]*Weather, error) {
+	url := req.buildURL(c.historicalForecastURL, c.apiKey)
+
+	return c.fetchMultiWeather(ctx, url, pastDateClass(req.endDate, time.Now()), func(body []byte) ([]*Weather, error) {
+		return decodeMultiWeather(body, req.format, req.variables(), len(req.locations), req.models)
+	})
+}
+
+// fetchWeather requests url and decodes the response. Concurrent identical
+// calls share the request and the decoded Weather, and get their own copy.
+func (c *Client) fetchWeather(ctx context.Context, url string, class cacheClass, decode func(body []byte) (*Weather, error)) (*Weather, error) {
+	return coalesce(ctx, &c.flights, "weather "+url, func(ctx context.Context) (*Weather, error) {
+		body, err := c.doRequest(ctx, url, class)
+		if err != nil {
+			return nil, err
+		}
+		return decode(body)
+	}, (*Weather).clone)
+}
+
+// fetchMultiWeather is fetchWeather for multi-location responses.
+func (c *Client) fetchMultiWeather(ctx context.Context, url string, class cacheClass, decode func(body []byte) ([]*Weather, error)) ([]*Weather, error) {
+	return coalesce(ctx, &c.flights, "weathers "+url, func(ctx context.Context) ([]*Weather, error) {
+		body, err := c.doRequest(ctx, url, class)
+		if err != nil {
+			return nil, err
+		}
+		return decode(body)
+	}, cloneWeathers)
+}
+
+// decodeWeather decodes a single-location Forecast or Historical API response
+// in the requested format.
+func decodeWeather(body []byte, format ResponseFormat, vars weatherVariables, models []Model) (*Weather, error) {
+	if format != FormatFlatBuffers {
+		return parseWeatherResponse(body, models...)
+	}
+	weathers, err := parseFlatBuffersResponse(body, vars, 1, models)
 	if err != nil {
 		return nil, err
 	}
+	return weathers[0], nil
+}
 
-	return parseWeatherResponse(body)
+// decodeMultiWeather decodes a multi-location Forecast or Historical API
+// response in the requested format.
+func decodeMultiWeather(body []byte, format ResponseFormat, vars weatherVariables, expected int, models []Model) ([]*Weather, error) {
+	if format != FormatFlatBuffers {
+		return parseMultiWeatherResponse(body, expected, models...)
+	}
+	return parseFlatBuffersResponse(body, vars, expected, models)
 }
 
 // doRequest performs an HTTP GET request and returns the response body.
-func (c *Client) doRequest(ctx context.Context, url string) ([]byte, error) {
+// If a retry policy is set, transient failures are retried; see WithRetry.
+// If a cache is set, cached responses are returned without a request; see
+// WithCache. The class of the response selects its cache TTL. Concurrent
+// identical requests share one call; the body they share must not be
+// modified.
+func (c *Client) doRequest(ctx context.Context, url string, class cacheClass) ([]byte, error) {
+	return coalesce(ctx, &c.flights, "body "+url, func(ctx context.Context) ([]byte, error) {
+		return c.request(ctx, url, class)
+	}, func(body []byte) []byte { return body })
+}
+
+// request performs a request, consulting the cache and retrying if configured.
+func (c *Client) request(ctx context.Context, url string, class cacheClass) ([]byte, error) {
+	var key string
+	var ttl time.Duration
+	if c.cache != nil {
+		if ttl = c.cacheTTL(class); ttl > 0 {
+			key = cacheKey(url)
+			if body, ok := c.cache.store.Get(ctx, key); ok {
+				c.cache.hits.Add(1)
+				return body, nil
+			}
+			c.cache.misses.Add(1)
+		}
+	}
+
+	var body []byte
+	var err error
+	if c.retry == nil {
+		body, err = c.attempt(ctx, url)
+	} else {
+		body, err = c.retry.do(ctx, func() ([]byte, error) { return c.attempt(ctx, url) })
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if key != "" {
+		c.cache.store.Set(ctx, key, body, ttl)
+	}
+	return body, nil
+}
+
+// attempt makes a single request and returns the response body.
+func (c *Client) attempt(ctx context.Context, url string) ([]byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx, RequestCost(url)); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
@@ -134,18 +381,20 @@ func (c *Client) doRequest(ctx context.Context, url string) ([]byte, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			Reason:     string(body),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
 		// Try to parse the error response
 		var errResp apiErrorResponse
 		if json.Unmarshal(body, &errResp) == nil && errResp.Error {
-			return nil, &APIError{
-				StatusCode: resp.StatusCode,
-				Reason:     errResp.Reason,
-			}
+			apiErr.Reason = errResp.Reason
 		}
-		return nil, &APIError{
-			StatusCode: resp.StatusCode,
-			Reason:     string(body),
+		if resp.StatusCode == http.StatusBadRequest {
+			apiErr.Parameter = parseParameter(apiErr.Reason)
 		}
+		return nil, apiErr
 	}
 
 	return body, nil
@@ -22,6 +22,13 @@ func (m *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	return m.response, m.err
 }
 
+// mockHTTPFunc is a mock HTTP client that builds a response per request.
+type mockHTTPFunc func(req *http.Request) (*http.Response, error)
+
+func (f mockHTTPFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 func newMockResponse(statusCode int, body []byte) *http.Response {
 	return &http.Response{
 		StatusCode: statusCode,
@@ -52,6 +59,31 @@ func TestClientForecast(t *testing.T) {
 	assert.Len(t, weather.Hourly.Temperature2m, 3)
 }
 
+func TestClientForecastModels(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_models.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{
+		response: newMockResponse(http.StatusOK, data),
+	}
+
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m, HourlyPrecipitation).
+		WithDaily(DailyTemperature2mMax).
+		WithModels(ModelECMWFIFS025, ModelICONSeamless)
+
+	weather, err := client.Forecast(context.Background(), req)
+	require.NoError(t, err)
+
+	require.NotNil(t, weather.ForModel(ModelECMWFIFS025))
+	require.NotNil(t, weather.ForModel(ModelICONSeamless))
+	assert.Equal(t, 2.1, weather.ForModel(ModelECMWFIFS025).Hourly.Temperature2m[0])
+	assert.Equal(t, 2.5, weather.ForModel(ModelICONSeamless).Hourly.Temperature2m[0])
+}
+
 func TestClientHistorical(t *testing.T) {
 	data, err := os.ReadFile("testdata/historical.json")
 	require.NoError(t, err)
@@ -74,6 +106,61 @@ func TestClientHistorical(t *testing.T) {
 	assert.Equal(t, 18.5, weather.Hourly.Temperature2m[0])
 }
 
+func TestClientHistoricalForecast(t *testing.T) {
+	data, err := os.ReadFile("testdata/historical.json")
+	require.NoError(t, err)
+
+	var requested *http.Request
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req
+		return newMockResponse(http.StatusOK, data), nil
+	})
+
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewHistoricalForecastRequest(52.52, 13.41, "2023-06-01", "2023-06-01")
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	weather, err := client.HistoricalForecast(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "historical-forecast-api.open-meteo.com", requested.URL.Host)
+	assert.Equal(t, "2023-06-01", requested.URL.Query().Get("start_date"))
+	require.NotNil(t, weather.Hourly)
+	assert.Equal(t, 18.5, weather.Hourly.Temperature2m[0])
+}
+
+func TestClientForecastMulti(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_multi.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{
+		response: newMockResponse(http.StatusOK, data),
+	}
+
+	client := NewClient(WithHTTPClient(mock))
+
+	berlin, err := NewLocation(52.52, 13.41)
+	require.NoError(t, err)
+	paris, err := NewLocation(48.85, 2.35)
+	require.NoError(t, err)
+
+	req, err := NewForecastRequestForLocations(berlin, paris)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	// Single-location method refuses a multi-location request
+	_, err = client.Forecast(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ForecastMulti")
+
+	weathers, err := client.ForecastMulti(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, weathers, 2)
+	assert.Equal(t, 6.1, weathers[1].Hourly.Temperature2m[0])
+}
+
 func TestClientAPIError(t *testing.T) {
 	data, err := os.ReadFile("testdata/error.json")
 	require.NoError(t, err)
@@ -100,12 +187,34 @@ func TestClientOptions(t *testing.T) {
 	client := NewClient(
 		WithForecastURL("https://custom-api.example.com/forecast"),
 		WithHistoricalURL("https://custom-archive.example.com/archive"),
+		WithGeocodingURL("https://custom-geocoding.example.com/search"),
+		WithElevationURL("https://custom-api.example.com/elevation"),
+		WithAirQualityURL("https://custom-air-quality.example.com/air-quality"),
+		WithMarineURL("https://custom-marine.example.com/marine"),
+		WithFloodURL("https://custom-flood.example.com/flood"),
+		WithEnsembleURL("https://custom-ensemble.example.com/ensemble"),
+		WithClimateURL("https://custom-climate.example.com/climate"),
+		WithSeasonalURL("https://custom-seasonal.example.com/seasonal"),
+		WithHistoricalForecastURL("https://custom-historical-forecast.example.com/forecast"),
+		WithPreviousRunsURL("https://custom-previous-runs.example.com/forecast"),
+		WithSatelliteURL("https://custom-satellite.example.com/archive"),
 		WithUserAgent("CustomAgent/1.0"),
 		WithAPIKey("test-api-key"),
 	)
 
 	assert.Equal(t, "https://custom-api.example.com/forecast", client.forecastURL)
 	assert.Equal(t, "https://custom-archive.example.com/archive", client.historicalURL)
+	assert.Equal(t, "https://custom-geocoding.example.com/search", client.geocodingURL)
+	assert.Equal(t, "https://custom-api.example.com/elevation", client.elevationURL)
+	assert.Equal(t, "https://custom-air-quality.example.com/air-quality", client.airQualityURL)
+	assert.Equal(t, "https://custom-marine.example.com/marine", client.marineURL)
+	assert.Equal(t, "https://custom-flood.example.com/flood", client.floodURL)
+	assert.Equal(t, "https://custom-ensemble.example.com/ensemble", client.ensembleURL)
+	assert.Equal(t, "https://custom-climate.example.com/climate", client.climateURL)
+	assert.Equal(t, "https://custom-seasonal.example.com/seasonal", client.seasonalURL)
+	assert.Equal(t, "https://custom-historical-forecast.example.com/forecast", client.historicalForecastURL)
+	assert.Equal(t, "https://custom-previous-runs.example.com/forecast", client.previousRunsURL)
+	assert.Equal(t, "https://custom-satellite.example.com/archive", client.satelliteURL)
 	assert.Equal(t, "CustomAgent/1.0", client.userAgent)
 	assert.Equal(t, "test-api-key", client.apiKey)
 }
@@ -115,6 +224,17 @@ func TestNewClientDefaults(t *testing.T) {
 
 	assert.Equal(t, forecastBaseURL, client.forecastURL)
 	assert.Equal(t, historicalBaseURL, client.historicalURL)
+	assert.Equal(t, geocodingBaseURL, client.geocodingURL)
+	assert.Equal(t, elevationBaseURL, client.elevationURL)
+	assert.Equal(t, airQualityBaseURL, client.airQualityURL)
+	assert.Equal(t, marineBaseURL, client.marineURL)
+	assert.Equal(t, floodBaseURL, client.floodURL)
+	assert.Equal(t, ensembleBaseURL, client.ensembleURL)
+	assert.Equal(t, climateBaseURL, client.climateURL)
+	assert.Equal(t, seasonalBaseURL, client.seasonalURL)
+	assert.Equal(t, historicalForecastBaseURL, client.historicalForecastURL)
+	assert.Equal(t, previousRunsBaseURL, client.previousRunsURL)
+	assert.Equal(t, satelliteBaseURL, client.satelliteURL)
 	assert.Equal(t, DefaultUserAgent, client.userAgent)
 	assert.Empty(t, client.apiKey)
 	assert.NotNil(t, client.httpClient)
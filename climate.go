@@ -0,0 +1,317 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// ClimateRequest represents a request to the Climate API.
+type ClimateRequest struct {
+	location Location
+	models   []ClimateModel
+
+	// Required date range (1950-01-01 to 2050-12-31)
+	startDate string
+	endDate   string
+
+	// Metrics to request
+	dailyMetrics []ClimateMetric
+
+	// Units
+	temperatureUnit   TemperatureUnit
+	windSpeedUnit     WindSpeedUnit
+	precipitationUnit PrecipitationUnit
+
+	// Other options
+	disableBiasCorrection bool
+	cellSelection         CellSelection
+}
+
+// NewClimateRequest creates a new ClimateRequest for the given coordinates,
+// date range and models. Dates should be in ISO8601 format (yyyy-mm-dd).
+// At least one climate model is required.
+func NewClimateRequest(lat, lon float64, startDate, endDate string, models ...ClimateModel) (*ClimateRequest, error) {
+	if startDate == "" {
+		return nil, fmt.Errorf("startDate is required for climate requests")
+	}
+	if endDate == "" {
+		return nil, fmt.Errorf("endDate is required for climate requests")
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("at least one climate model is required")
+	}
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &ClimateRequest{
+		location:  loc,
+		models:    slices.Clone(models),
+		startDate: startDate,
+		endDate:   endDate,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *ClimateRequest) WithLocation(loc Location) *ClimateRequest {
+	r.location = loc
+	return r
+}
+
+// WithModels adds climate models to the request.
+func (r *ClimateRequest) WithModels(models ...ClimateModel) *ClimateRequest {
+	r.models = append(r.models, models...)
+	return r
+}
+
+// WithDaily adds daily metrics to the request.
+func (r *ClimateRequest) WithDaily(metrics ...ClimateMetric) *ClimateRequest {
+	r.dailyMetrics = append(r.dailyMetrics, metrics...)
+	return r
+}
+
+// WithTemperatureUnit sets the temperature unit.
+func (r *ClimateRequest) WithTemperatureUnit(unit TemperatureUnit) *ClimateRequest {
+	r.temperatureUnit = unit
+	return r
+}
+
+// WithWindSpeedUnit sets the wind speed unit.
+func (r *ClimateRequest) WithWindSpeedUnit(unit WindSpeedUnit) *ClimateRequest {
+	r.windSpeedUnit = unit
+	return r
+}
+
+// WithPrecipitationUnit sets the precipitation unit.
+func (r *ClimateRequest) WithPrecipitationUnit(unit PrecipitationUnit) *ClimateRequest {
+	r.precipitationUnit = unit
+	return r
+}
+
+// WithBiasCorrection enables or disables the statistical downscaling and
+// bias correction applied by the API (enabled by default). Disable it to
+// get raw model output.
+func (r *ClimateRequest) WithBiasCorrection(enabled bool) *ClimateRequest {
+	r.disableBiasCorrection = !enabled
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+func (r *ClimateRequest) WithCellSelection(selection CellSelection) *ClimateRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// modelNames returns the requested models as strings.
+func (r *ClimateRequest) modelNames() []string {
+	names := make([]string, len(r.models))
+	for i, m := range r.models {
+		names[i] = string(m)
+	}
+	return names
+}
+
+// buildURL builds the URL for a climate request.
+func (r *ClimateRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Required date range and models
+	params.Set("start_date", r.startDate)
+	params.Set("end_date", r.endDate)
+	params.Set("models", joinMetrics(r.models))
+
+	// Metrics
+	if len(r.dailyMetrics) > 0 {
+		params.Set("daily", joinMetrics(r.dailyMetrics))
+	}
+
+	// Units
+	if r.temperatureUnit != "" {
+		params.Set("temperature_unit", string(r.temperatureUnit))
+	}
+	if r.windSpeedUnit != "" {
+		params.Set("wind_speed_unit", string(r.windSpeedUnit))
+	}
+	if r.precipitationUnit != "" {
+		params.Set("precipitation_unit", string(r.precipitationUnit))
+	}
+
+	// Other options
+	if r.disableBiasCorrection {
+		params.Set("disable_bias_correction", "true")
+	}
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// Climate contains the response from the Climate API.
+type Climate struct {
+	// Location information
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// Daily contains the daily data of each requested model.
+	Daily      map[ClimateModel]*ClimateDailyData  `json:"-"` // parsed separately
+	DailyUnits map[ClimateModel]*ClimateDailyUnits `json:"-"` // parsed separately
+}
+
+// ClimateDailyData contains daily climate projections of a single model.
+// Missing data points are stored as NaN; see Series.
+type ClimateDailyData struct {
+	// Time contains timestamps for each day (at 00:00).
+	Times []time.Time `json:"-"` // parsed separately from "time" field
+
+	// Temperature
+	Temperature2mMean Series `json:"temperature_2m_mean,omitempty"`
+	Temperature2mMax  Series `json:"temperature_2m_max,omitempty"`
+	Temperature2mMin  Series `json:"temperature_2m_min,omitempty"`
+
+	// Humidity and dew point
+	RelativeHumidity2mMean Series `json:"relative_humidity_2m_mean,omitempty"`
+	RelativeHumidity2mMax  Series `json:"relative_humidity_2m_max,omitempty"`
+	RelativeHumidity2mMin  Series `json:"relative_humidity_2m_min,omitempty"`
+	DewPoint2mMean         Series `json:"dew_point_2m_mean,omitempty"`
+	DewPoint2mMax          Series `json:"dew_point_2m_max,omitempty"`
+	DewPoint2mMin          Series `json:"dew_point_2m_min,omitempty"`
+
+	// Wind
+	WindSpeed10mMean Series `json:"wind_speed_10m_mean,omitempty"`
+	WindSpeed10mMax  Series `json:"wind_speed_10m_max,omitempty"`
+
+	// Precipitation
+	PrecipitationSum Series `json:"precipitation_sum,omitempty"`
+	RainSum          Series `json:"rain_sum,omitempty"`
+	SnowfallSum      Series `json:"snowfall_sum,omitempty"`
+
+	// Clouds, pressure and radiation
+	CloudCoverMean        Series `json:"cloud_cover_mean,omitempty"`
+	PressureMSLMean       Series `json:"pressure_msl_mean,omitempty"`
+	ShortwaveRadiationSum Series `json:"shortwave_radiation_sum,omitempty"`
+
+	// Soil and evapotranspiration
+	SoilMoisture0To10cmMean     Series `json:"soil_moisture_0_to_10cm_mean,omitempty"`
+	ET0FAOEvapotranspirationSum Series `json:"et0_fao_evapotranspiration_sum,omitempty"`
+}
+
+// ClimateDailyUnits contains unit strings for daily climate metrics.
+type ClimateDailyUnits struct {
+	Temperature2mMean           string `json:"temperature_2m_mean,omitempty"`
+	Temperature2mMax            string `json:"temperature_2m_max,omitempty"`
+	Temperature2mMin            string `json:"temperature_2m_min,omitempty"`
+	RelativeHumidity2mMean      string `json:"relative_humidity_2m_mean,omitempty"`
+	RelativeHumidity2mMax       string `json:"relative_humidity_2m_max,omitempty"`
+	RelativeHumidity2mMin       string `json:"relative_humidity_2m_min,omitempty"`
+	DewPoint2mMean              string `json:"dew_point_2m_mean,omitempty"`
+	DewPoint2mMax               string `json:"dew_point_2m_max,omitempty"`
+	DewPoint2mMin               string `json:"dew_point_2m_min,omitempty"`
+	WindSpeed10mMean            string `json:"wind_speed_10m_mean,omitempty"`
+	WindSpeed10mMax             string `json:"wind_speed_10m_max,omitempty"`
+	PrecipitationSum            string `json:"precipitation_sum,omitempty"`
+	RainSum                     string `json:"rain_sum,omitempty"`
+	SnowfallSum                 string `json:"snowfall_sum,omitempty"`
+	CloudCoverMean              string `json:"cloud_cover_mean,omitempty"`
+	PressureMSLMean             string `json:"pressure_msl_mean,omitempty"`
+	ShortwaveRadiationSum       string `json:"shortwave_radiation_sum,omitempty"`
+	SoilMoisture0To10cmMean     string `json:"soil_moisture_0_to_10cm_mean,omitempty"`
+	ET0FAOEvapotranspirationSum string `json:"et0_fao_evapotranspiration_sum,omitempty"`
+}
+
+// rawClimateResponse represents the raw JSON response from the Climate API.
+type rawClimateResponse struct {
+	rawMeta
+
+	Daily      json.RawMessage `json:"daily,omitempty"`
+	DailyUnits json.RawMessage `json:"daily_units,omitempty"`
+}
+
+// parseClimateResponse parses the Climate API response into a Climate
+// struct. Models are needed to split variables when several were requested.
+func parseClimateResponse(body []byte, models []string) (*Climate, error) {
+	var raw rawClimateResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	climate := &Climate{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Elevation:            raw.Elevation,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+	}
+
+	// Parse daily data per model
+	if len(raw.Daily) > 0 {
+		blocks, err := splitModelBlock(raw.Daily, models)
+		if err != nil {
+			return nil, &DecodeError{Block: "daily", Err: err}
+		}
+		loc := raw.timeLocation()
+		climate.Daily = make(map[ClimateModel]*ClimateDailyData, len(blocks))
+		for model, block := range blocks {
+			daily := &ClimateDailyData{}
+			times, err := parseDateBlock(block, loc, daily)
+			if err != nil {
+				return nil, &DecodeError{Block: "daily", Model: Model(model), Err: err}
+			}
+			daily.Times = times
+			climate.Daily[ClimateModel(model)] = daily
+		}
+	}
+
+	// Parse daily units per model
+	if len(raw.DailyUnits) > 0 {
+		blocks, err := splitModelBlock(raw.DailyUnits, models)
+		if err != nil {
+			return nil, &DecodeError{Block: "daily_units", Err: err}
+		}
+		climate.DailyUnits = make(map[ClimateModel]*ClimateDailyUnits, len(blocks))
+		for model, block := range blocks {
+			units := &ClimateDailyUnits{}
+			if err := json.Unmarshal(block, units); err != nil {
+				return nil, &DecodeError{Block: "daily_units", Model: Model(model), Err: err}
+			}
+			climate.DailyUnits[ClimateModel(model)] = units
+		}
+	}
+
+	return climate, nil
+}
+
+// Climate retrieves climate projections for the given request.
+func (c *Client) Climate(ctx context.Context, req *ClimateRequest) (*Climate, error) {
+	url := req.buildURL(c.climateURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, cacheArchive)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseClimateResponse(body, req.modelNames())
+}
@@ -0,0 +1,62 @@
+package omgo
+
+// ClimateMetric represents a daily metric that can be requested from the Climate API.
+type ClimateMetric string
+
+// Daily metrics available from the Open-Meteo Climate API.
+const (
+	// Temperature
+	ClimateTemperature2mMean ClimateMetric = "temperature_2m_mean"
+	ClimateTemperature2mMax  ClimateMetric = "temperature_2m_max"
+	ClimateTemperature2mMin  ClimateMetric = "temperature_2m_min"
+
+	// Humidity and dew point
+	ClimateRelativeHumidity2mMean ClimateMetric = "relative_humidity_2m_mean"
+	ClimateRelativeHumidity2mMax  ClimateMetric = "relative_humidity_2m_max"
+	ClimateRelativeHumidity2mMin  ClimateMetric = "relative_humidity_2m_min"
+	ClimateDewPoint2mMean         ClimateMetric = "dew_point_2m_mean"
+	ClimateDewPoint2mMax          ClimateMetric = "dew_point_2m_max"
+	ClimateDewPoint2mMin          ClimateMetric = "dew_point_2m_min"
+
+	// Wind
+	ClimateWindSpeed10mMean ClimateMetric = "wind_speed_10m_mean"
+	ClimateWindSpeed10mMax  ClimateMetric = "wind_speed_10m_max"
+
+	// Precipitation
+	ClimatePrecipitationSum ClimateMetric = "precipitation_sum"
+	ClimateRainSum          ClimateMetric = "rain_sum"
+	ClimateSnowfallSum      ClimateMetric = "snowfall_sum"
+
+	// Clouds, pressure and radiation
+	ClimateCloudCoverMean        ClimateMetric = "cloud_cover_mean"
+	ClimatePressureMSLMean       ClimateMetric = "pressure_msl_mean"
+	ClimateShortwaveRadiationSum ClimateMetric = "shortwave_radiation_sum"
+
+	// Soil and evapotranspiration
+	ClimateSoilMoisture0To10cmMean     ClimateMetric = "soil_moisture_0_to_10cm_mean"
+	ClimateET0FAOEvapotranspirationSum ClimateMetric = "et0_fao_evapotranspiration_sum"
+)
+
+// String returns the API parameter string for the metric.
+func (m ClimateMetric) String() string {
+	return string(m)
+}
+
+// ClimateModel selects a CMIP6 HighResMIP model for the Climate API.
+type ClimateModel string
+
+// Climate models available from the Open-Meteo Climate API.
+const (
+	ClimateCMCCCM2VHR4 ClimateModel = "CMCC_CM2_VHR4"
+	ClimateFGOALSF3H   ClimateModel = "FGOALS_f3_H"
+	ClimateHiRAMSITHR  ClimateModel = "HiRAM_SIT_HR"
+	ClimateMRIAGCM32S  ClimateModel = "MRI_AGCM3_2_S"
+	ClimateECEarth3PHR ClimateModel = "EC_Earth3P_HR"
+	ClimateMPIESM12XR  ClimateModel = "MPI_ESM1_2_XR"
+	ClimateNICAM168S   ClimateModel = "NICAM16_8S"
+)
+
+// String returns the API parameter string for the model.
+func (m ClimateModel) String() string {
+	return string(m)
+}
@@ -0,0 +1,110 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClimateRequestValidation(t *testing.T) {
+	_, err := NewClimateRequest(52.52, 13.41, "2050-01-01", "2050-12-31")
+	assert.Error(t, err, "models are required")
+
+	_, err = NewClimateRequest(52.52, 13.41, "", "2050-12-31", ClimateMRIAGCM32S)
+	assert.Error(t, err, "start date is required")
+}
+
+func TestClimateRequestURL(t *testing.T) {
+	req, err := NewClimateRequest(52.52, 13.41, "2050-01-01", "2050-12-31", ClimateMRIAGCM32S, ClimateECEarth3PHR)
+	require.NoError(t, err)
+
+	req.WithDaily(ClimateTemperature2mMax, ClimatePrecipitationSum).
+		WithPrecipitationUnit(Inches)
+
+	parsed, err := url.Parse(req.buildURL(climateBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "2050-01-01", params.Get("start_date"))
+	assert.Equal(t, "2050-12-31", params.Get("end_date"))
+	assert.Equal(t, "EC_Earth3P_HR,MRI_AGCM3_2_S", params.Get("models"))
+	assert.Equal(t, "precipitation_sum,temperature_2m_max", params.Get("daily"))
+	assert.Equal(t, "inch", params.Get("precipitation_unit"))
+	assert.Empty(t, params.Get("disable_bias_correction"))
+
+	req.WithBiasCorrection(false)
+	parsed, err = url.Parse(req.buildURL(climateBaseURL, ""))
+	require.NoError(t, err)
+	assert.Equal(t, "true", parsed.Query().Get("disable_bias_correction"))
+}
+
+func TestNewClimateRequestCopiesModels(t *testing.T) {
+	models := make([]ClimateModel, 1, 2)
+	models[0] = ClimateMRIAGCM32S
+
+	req, err := NewClimateRequest(52.52, 13.41, "2050-01-01", "2050-12-31", models...)
+	require.NoError(t, err)
+	req.WithModels(ClimateECEarth3PHR)
+
+	assert.Equal(t, ClimateModel(""), models[:2][1], "caller's array is not modified")
+	assert.Equal(t, []ClimateModel{ClimateMRIAGCM32S, ClimateECEarth3PHR}, req.models)
+}
+
+func TestParseClimate(t *testing.T) {
+	data, err := os.ReadFile("testdata/climate.json")
+	require.NoError(t, err)
+
+	climate, err := parseClimateResponse(data, []string{"MRI_AGCM3_2_S", "EC_Earth3P_HR"})
+	require.NoError(t, err)
+
+	require.Len(t, climate.Daily, 2)
+
+	mri := climate.Daily[ClimateMRIAGCM32S]
+	require.NotNil(t, mri)
+	require.Len(t, mri.Times, 3)
+	assert.Equal(t, time.Date(2050, 1, 2, 0, 0, 0, 0, time.UTC), mri.Times[1].UTC())
+	assert.Equal(t, 3.4, mri.Temperature2mMax[0])
+	assert.True(t, mri.Temperature2mMax.IsMissing(2))
+	assert.Equal(t, Series{0, 1.2, 4.5}, mri.PrecipitationSum)
+
+	ec := climate.Daily[ClimateECEarth3PHR]
+	require.NotNil(t, ec)
+	assert.Len(t, ec.Times, 3)
+	assert.Equal(t, -0.5, ec.Temperature2mMax[2])
+
+	require.NotNil(t, climate.DailyUnits[ClimateECEarth3PHR])
+	assert.Equal(t, "mm", climate.DailyUnits[ClimateECEarth3PHR].PrecipitationSum)
+}
+
+func TestParseClimateSingleModel(t *testing.T) {
+	body := []byte(`{"daily":{"time":["2050-01-01"],"temperature_2m_mean":[4.2]},"daily_units":{"temperature_2m_mean":"°C"}}`)
+
+	climate, err := parseClimateResponse(body, []string{"NICAM16_8S"})
+	require.NoError(t, err)
+
+	daily := climate.Daily[ClimateNICAM168S]
+	require.NotNil(t, daily)
+	assert.Equal(t, Series{4.2}, daily.Temperature2mMean)
+	assert.Equal(t, "°C", climate.DailyUnits[ClimateNICAM168S].Temperature2mMean)
+}
+
+func TestClientClimate(t *testing.T) {
+	data, err := os.ReadFile("testdata/climate.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{response: newMockResponse(http.StatusOK, data)}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewClimateRequest(52.52, 13.41, "2050-01-01", "2050-01-03", ClimateMRIAGCM32S, ClimateECEarth3PHR)
+	require.NoError(t, err)
+
+	climate, err := client.Climate(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, climate.Daily, 2)
+}
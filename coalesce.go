@@ -0,0 +1,181 @@
+package omgo
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// flightGroup de-duplicates concurrent identical calls: callers asking for
+// a key that is already in flight wait for that call instead of starting
+// their own.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall is a call in flight.
+type flightCall struct {
+	done    chan struct{}
+	cancel  context.CancelCauseFunc
+	waiters int  // callers still waiting for the result
+	shared  bool // whether the result went to more than one caller
+	val     any
+	err     error
+}
+
+// do runs fn once for all concurrent callers of key and returns its result,
+// reporting whether the result is shared with other callers. fn runs with
+// a context that is canceled only when every caller's context is done, so
+// one caller giving up doesn't fail the others. The last caller to give up
+// cancels fn with its context's error and returns fn's result.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (val any, shared bool, err error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	call, ok := g.calls[key]
+	if !ok {
+		fctx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+		call = &flightCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+		go func() {
+			val, err := fn(causeContext{fctx})
+			cancel(nil)
+
+			g.mu.Lock()
+			call.val, call.err = val, err
+			call.shared = call.waiters > 1
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.val, call.shared, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		last := call.waiters == 0
+		if last {
+			// Nobody else is waiting; later callers start a new call
+			call.cancel(ctx.Err())
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		if !last {
+			return nil, false, ctx.Err()
+		}
+		<-call.done
+		return call.val, call.shared, call.err
+	}
+}
+
+// causeContext reports the cause of its cancellation as its error, so fn
+// sees the error of the caller's context, such as a deadline.
+type causeContext struct {
+	context.Context
+}
+
+// Err implements context.Context.
+func (c causeContext) Err() error {
+	if c.Context.Err() == nil {
+		return nil
+	}
+	return context.Cause(c.Context)
+}
+
+// coalesce runs fn once for all concurrent callers of key. Shared results
+// are copied with clone, so callers never alias each other's data.
+func coalesce[T any](ctx context.Context, g *flightGroup, key string, fn func(ctx context.Context) (T, error), clone func(T) T) (T, error) {
+	val, shared, err := g.do(ctx, key, func(ctx context.Context) (any, error) {
+		return fn(ctx)
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if shared {
+		return clone(val.(T)), nil
+	}
+	return val.(T), nil
+}
+
+// clone returns a deep copy of the Weather.
+func (w *Weather) clone() *Weather {
+	return deepCopy(w)
+}
+
+// cloneWeathers returns deep copies of the Weathers.
+func cloneWeathers(ws []*Weather) []*Weather {
+	return deepCopy(ws)
+}
+
+// deepCopy returns a deep copy of v, following pointers, slices and maps.
+// Pointers shared within v, such as a single-model response's top-level
+// blocks and Models entry, stay shared within the copy. Unexported fields,
+// such as those of time.Time, are copied shallowly.
+func deepCopy[T any](v T) T {
+	seen := make(map[copiedPointer]reflect.Value)
+	return copyValue(reflect.ValueOf(v), seen).Interface().(T)
+}
+
+// copiedPointer identifies a pointer copied by deepCopy.
+type copiedPointer struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+func copyValue(v reflect.Value, seen map[copiedPointer]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		key := copiedPointer{v.Type(), v.Pointer()}
+		if c, ok := seen[key]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[key] = c
+		c.Elem().Set(copyValue(v.Elem(), seen))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(v.Field(i), seen))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i), seen))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value(), seen))
+		}
+		return c
+	}
+	return v
+}
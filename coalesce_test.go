@@ -0,0 +1,148 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCoalescesRequests(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	const callers = 5
+	var requests atomic.Int32
+	release := make(chan struct{})
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requests.Add(1)
+		<-release
+		return newMockResponse(http.StatusOK, data), nil
+	})
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	results := make([]*Weather, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			weather, err := client.Forecast(context.Background(), req)
+			assert.NoError(t, err)
+			results[i] = weather
+		}(i)
+	}
+
+	// Let all callers join the call in flight
+	require.Eventually(t, func() bool {
+		client.flights.mu.Lock()
+		defer client.flights.mu.Unlock()
+		call := client.flights.calls["weather "+req.buildURL(client.forecastURL, "")]
+		return call != nil && call.waiters == callers
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Callers get equal but independent copies
+	results[0].Hourly.Temperature2m[0] = 99
+	for _, w := range results[1:] {
+		require.NotNil(t, w)
+		assert.NotSame(t, results[0], w)
+		assert.Equal(t, 2.5, w.Hourly.Temperature2m[0])
+	}
+}
+
+func TestClientCoalescingCancellation(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		select {
+		case <-release:
+			return newMockResponse(http.StatusOK, data), nil
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	})
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	key := "weather " + req.buildURL(client.forecastURL, "")
+	waiters := func() int {
+		client.flights.mu.Lock()
+		defer client.flights.mu.Unlock()
+		if call := client.flights.calls[key]; call != nil {
+			return call.waiters
+		}
+		return 0
+	}
+
+	// The first caller gives up, the second still gets the result
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err := client.Forecast(ctx, req)
+		firstErr <- err
+	}()
+	require.Eventually(t, func() bool { return waiters() == 1 }, time.Second, time.Millisecond)
+
+	second := make(chan *Weather)
+	go func() {
+		weather, err := client.Forecast(context.Background(), req)
+		assert.NoError(t, err)
+		second <- weather
+	}()
+	require.Eventually(t, func() bool { return waiters() == 2 }, time.Second, time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+	close(release)
+	assert.NotNil(t, (<-second).Hourly)
+
+	// The last caller to give up cancels the call with its context's error
+	blocking := NewClient(WithHTTPClient(mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})))
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = blocking.Forecast(ctx, req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestDeepCopy(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_extra.json")
+	require.NoError(t, err)
+	weather, err := parseWeatherResponse(data, ModelICONSeamless)
+	require.NoError(t, err)
+
+	c := weather.clone()
+	assert.Equal(t, weather.Current, c.Current)
+	assert.Equal(t, weather.Daily, c.Daily)
+	assert.Equal(t, weather.Hourly.Times, c.Hourly.Times)
+	assert.Equal(t, weather.HourlyUnits, c.HourlyUnits)
+	assert.NotSame(t, weather.Hourly, c.Hourly)
+	assert.NotSame(t, &weather.Hourly.Temperature2m[0], &c.Hourly.Temperature2m[0])
+
+	// Shared pointers stay shared within the copy
+	assert.Same(t, c.Hourly, c.ForModel(ModelICONSeamless).Hourly)
+
+	c.Hourly.Extra["boundary_layer_height"][0] = 0
+	c.HourlyUnits.Extra["boundary_layer_height"] = "ft"
+	assert.Equal(t, 450.0, weather.Hourly.Extra["boundary_layer_height"][0])
+	assert.Equal(t, "m", weather.HourlyUnits.Extra["boundary_layer_height"])
+}
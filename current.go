@@ -26,9 +26,35 @@ type CurrentData struct {
 	WindSpeed10m        *float64     `json:"wind_speed_10m,omitempty"`
 	WindDirection10m    *float64     `json:"wind_direction_10m,omitempty"`
 	WindGusts10m        *float64     `json:"wind_gusts_10m,omitempty"`
+
+	// Extra contains returned variables that have no field above.
+	Extra map[string]float64 `json:"-"`
 }
 
 // IsDaytime returns true if it's currently daytime at the location.
 func (c *CurrentData) IsDaytime() bool {
 	return c.IsDay != nil && *c.IsDay == 1
 }
+
+// Variables returns the metrics that were returned, in field order followed
+// by variables in Extra sorted by name.
+func (c *CurrentData) Variables() []CurrentMetric {
+	if c == nil {
+		return nil
+	}
+	return returned[CurrentMetric](currentFields().returnedValues(c), c.Extra)
+}
+
+// Value returns the value of the given metric and whether it was returned.
+// Weather code and is_day are converted to float64. Variables without a
+// field are looked up in Extra.
+func (c *CurrentData) Value(metric CurrentMetric) (float64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	if v, ok := currentFields().value(c, string(metric)); ok {
+		return v, true
+	}
+	v, ok := c.Extra[string(metric)]
+	return v, ok
+}
@@ -1,57 +1,103 @@
 package omgo
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // DailyData contains daily aggregated weather data.
+// Missing data points are stored as NaN; see Series.
 type DailyData struct {
 	// Time contains timestamps for each day (at 00:00).
 	Times []time.Time `json:"-"` // parsed separately from "time" field
 
 	// Weather code (most severe of the day)
-	WeatherCode []WeatherCode `json:"weather_code,omitempty"`
+	WeatherCode WeatherCodes `json:"weather_code,omitempty"`
 
 	// Temperature
-	Temperature2mMax  []float64 `json:"temperature_2m_max,omitempty"`
-	Temperature2mMin  []float64 `json:"temperature_2m_min,omitempty"`
-	Temperature2mMean []float64 `json:"temperature_2m_mean,omitempty"`
+	Temperature2mMax  Series `json:"temperature_2m_max,omitempty"`
+	Temperature2mMin  Series `json:"temperature_2m_min,omitempty"`
+	Temperature2mMean Series `json:"temperature_2m_mean,omitempty"`
 
 	// Apparent temperature
-	ApparentTemperatureMax  []float64 `json:"apparent_temperature_max,omitempty"`
-	ApparentTemperatureMin  []float64 `json:"apparent_temperature_min,omitempty"`
-	ApparentTemperatureMean []float64 `json:"apparent_temperature_mean,omitempty"`
+	ApparentTemperatureMax  Series `json:"apparent_temperature_max,omitempty"`
+	ApparentTemperatureMin  Series `json:"apparent_temperature_min,omitempty"`
+	ApparentTemperatureMean Series `json:"apparent_temperature_mean,omitempty"`
 
 	// Sun times (as time.Time)
 	Sunrise []time.Time `json:"-"` // parsed separately
 	Sunset  []time.Time `json:"-"` // parsed separately
 
 	// Sunshine and daylight duration (seconds)
-	SunshineDuration []float64 `json:"sunshine_duration,omitempty"`
-	DaylightDuration []float64 `json:"daylight_duration,omitempty"`
+	SunshineDuration Series `json:"sunshine_duration,omitempty"`
+	DaylightDuration Series `json:"daylight_duration,omitempty"`
 
 	// Precipitation
-	PrecipitationSum   []float64 `json:"precipitation_sum,omitempty"`
-	RainSum            []float64 `json:"rain_sum,omitempty"`
-	ShowersSum         []float64 `json:"showers_sum,omitempty"`
-	SnowfallSum        []float64 `json:"snowfall_sum,omitempty"`
-	PrecipitationHours []float64 `json:"precipitation_hours,omitempty"`
+	PrecipitationSum   Series `json:"precipitation_sum,omitempty"`
+	RainSum            Series `json:"rain_sum,omitempty"`
+	ShowersSum         Series `json:"showers_sum,omitempty"`
+	SnowfallSum        Series `json:"snowfall_sum,omitempty"`
+	PrecipitationHours Series `json:"precipitation_hours,omitempty"`
 
 	// Precipitation probability
-	PrecipitationProbabilityMax  []float64 `json:"precipitation_probability_max,omitempty"`
-	PrecipitationProbabilityMin  []float64 `json:"precipitation_probability_min,omitempty"`
-	PrecipitationProbabilityMean []float64 `json:"precipitation_probability_mean,omitempty"`
+	PrecipitationProbabilityMax  Series `json:"precipitation_probability_max,omitempty"`
+	PrecipitationProbabilityMin  Series `json:"precipitation_probability_min,omitempty"`
+	PrecipitationProbabilityMean Series `json:"precipitation_probability_mean,omitempty"`
 
 	// Wind
-	WindSpeed10mMax          []float64 `json:"wind_speed_10m_max,omitempty"`
-	WindGusts10mMax          []float64 `json:"wind_gusts_10m_max,omitempty"`
-	WindDirection10mDominant []float64 `json:"wind_direction_10m_dominant,omitempty"`
+	WindSpeed10mMax          Series `json:"wind_speed_10m_max,omitempty"`
+	WindGusts10mMax          Series `json:"wind_gusts_10m_max,omitempty"`
+	WindDirection10mDominant Series `json:"wind_direction_10m_dominant,omitempty"`
 
 	// Radiation (MJ/m²)
-	ShortwaveRadiationSum []float64 `json:"shortwave_radiation_sum,omitempty"`
+	ShortwaveRadiationSum Series `json:"shortwave_radiation_sum,omitempty"`
 
 	// Evapotranspiration
-	ET0FAOEvapotranspiration []float64 `json:"et0_fao_evapotranspiration,omitempty"`
+	ET0FAOEvapotranspiration Series `json:"et0_fao_evapotranspiration,omitempty"`
 
 	// UV Index
-	UVIndexMax         []float64 `json:"uv_index_max,omitempty"`
-	UVIndexClearSkyMax []float64 `json:"uv_index_clear_sky_max,omitempty"`
+	UVIndexMax         Series `json:"uv_index_max,omitempty"`
+	UVIndexClearSkyMax Series `json:"uv_index_clear_sky_max,omitempty"`
+
+	// Extra contains returned variables that have no field above.
+	Extra map[string]Series `json:"-"`
+}
+
+// UnmarshalJSON decodes daily data, keeping unknown variables in Extra.
+func (d *DailyData) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	return d.decodeFields(fields)
+}
+
+// decodeFields decodes the members of a daily data block.
+func (d *DailyData) decodeFields(fields map[string]json.RawMessage) (err error) {
+	d.Extra, err = decodeFields[Series](fields, d, dailyFields())
+	return err
+}
+
+// Variables returns the numeric metrics that were returned, in field order
+// followed by variables in Extra sorted by name. Sunrise and sunset are not
+// included.
+func (d *DailyData) Variables() []DailyMetric {
+	if d == nil {
+		return nil
+	}
+	return returned[DailyMetric](dailyFields().returnedSeries(d), d.Extra)
+}
+
+// Series returns the values of the given metric and whether it was returned.
+// Sunrise and sunset are not numeric and are only available through their
+// fields. Variables without a field are looked up in Extra.
+func (d *DailyData) Series(metric DailyMetric) (Series, bool) {
+	if d == nil {
+		return nil, false
+	}
+	if s, ok := dailyFields().series(d, string(metric)); ok {
+		return s, true
+	}
+	s, ok := d.Extra[string(metric)]
+	return s, ok
 }
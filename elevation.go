@@ -0,0 +1,106 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MaxElevationLocations is the maximum number of coordinates the Elevation API
+// accepts in a single call.
+const MaxElevationLocations = 100
+
+// elevationResponse represents the JSON response of the Elevation API.
+type elevationResponse struct {
+	Elevation []float64 `json:"elevation"`
+}
+
+// buildElevationURL builds the URL for an elevation lookup.
+// Elevation overrides on the locations are ignored.
+func buildElevationURL(baseURL, apiKey string, locs []Location) string {
+	lats := make([]string, len(locs))
+	lons := make([]string, len(locs))
+	for i, loc := range locs {
+		lats[i] = formatFloat(loc.Latitude)
+		lons[i] = formatFloat(loc.Longitude)
+	}
+
+	params := url.Values{}
+	params.Set("latitude", strings.Join(lats, ","))
+	params.Set("longitude", strings.Join(lons, ","))
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// Elevation looks up the terrain elevation in meters for each location,
+// based on a 90 meter digital elevation model. The result has the same
+// order as locs. At most MaxElevationLocations can be looked up per call.
+func (c *Client) Elevation(ctx context.Context, locs ...Location) ([]float64, error) {
+	if len(locs) == 0 {
+		return nil, fmt.Errorf("at least one location is required")
+	}
+	if len(locs) > MaxElevationLocations {
+		return nil, fmt.Errorf("at most %d locations per elevation request, got %d", MaxElevationLocations, len(locs))
+	}
+	for i, loc := range locs {
+		if err := loc.validate(); err != nil {
+			return nil, fmt.Errorf("location %d: %w", i, err)
+		}
+	}
+
+	url := buildElevationURL(c.elevationURL, c.apiKey, locs)
+
+	body, err := c.doRequest(ctx, url, cacheArchive)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp elevationResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+	if len(resp.Elevation) != len(locs) {
+		return nil, &DecodeError{Err: fmt.Errorf("expected %d elevations in response, got %d", len(locs), len(resp.Elevation))}
+	}
+	return resp.Elevation, nil
+}
+
+// FillElevation sets the Elevation of every location in locs that does not
+// already have one, using the Elevation API. Existing overrides are kept.
+// Any number of locations is supported; lookups are batched per
+// MaxElevationLocations. On error, locs is left unchanged.
+func (c *Client) FillElevation(ctx context.Context, locs []Location) error {
+	var missing []int
+	for i, loc := range locs {
+		if loc.Elevation == nil {
+			missing = append(missing, i)
+		}
+	}
+
+	elevations := make([]float64, 0, len(missing))
+	for start := 0; start < len(missing); start += MaxElevationLocations {
+		end := min(start+MaxElevationLocations, len(missing))
+		batch := make([]Location, end-start)
+		for j, idx := range missing[start:end] {
+			batch[j] = locs[idx]
+		}
+
+		result, err := c.Elevation(ctx, batch...)
+		if err != nil {
+			return err
+		}
+		elevations = append(elevations, result...)
+	}
+
+	for j, idx := range missing {
+		locs[idx] = locs[idx].WithElevation(elevations[j])
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package omgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// elevationMock answers elevation lookups with the latitude as elevation.
+func elevationMock(calls *int) mockHTTPFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		*calls++
+		lats := strings.Split(req.URL.Query().Get("latitude"), ",")
+		return newMockResponse(http.StatusOK, []byte(fmt.Sprintf(`{"elevation":[%s]}`, strings.Join(lats, ",")))), nil
+	}
+}
+
+func TestClientElevation(t *testing.T) {
+	var calls int
+	client := NewClient(WithHTTPClient(elevationMock(&calls)))
+
+	berlin, err := NewLocation(52.52, 13.41)
+	require.NoError(t, err)
+	paris, err := NewLocation(48.85, 2.35)
+	require.NoError(t, err)
+
+	elevations, err := client.Elevation(context.Background(), berlin, paris)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{52.52, 48.85}, elevations)
+	assert.Equal(t, 1, calls)
+
+	_, err = client.Elevation(context.Background())
+	assert.Error(t, err)
+
+	_, err = client.Elevation(context.Background(), make([]Location, MaxElevationLocations+1)...)
+	assert.Error(t, err)
+}
+
+func TestClientFillElevation(t *testing.T) {
+	var calls int
+	client := NewClient(WithHTTPClient(elevationMock(&calls)))
+
+	locs := make([]Location, 150)
+	for i := range locs {
+		locs[i] = Location{Latitude: float64(i) / 10}
+	}
+	locs[3] = locs[3].WithElevation(-5) // existing override is kept
+
+	require.NoError(t, client.FillElevation(context.Background(), locs))
+	assert.Equal(t, 2, calls)
+
+	for i, loc := range locs {
+		require.NotNil(t, loc.Elevation, "location %d", i)
+		if i == 3 {
+			assert.Equal(t, -5.0, *loc.Elevation)
+			continue
+		}
+		assert.Equal(t, loc.Latitude, *loc.Elevation)
+	}
+}
+
+func TestClientFillElevationError(t *testing.T) {
+	mock := &mockHTTPClient{
+		response: newMockResponse(http.StatusBadRequest, []byte(`{"error":true,"reason":"Latitude must be in range of -90 to 90°."}`)),
+	}
+	client := NewClient(WithHTTPClient(mock))
+
+	locs := []Location{{Latitude: 52.52, Longitude: 13.41}}
+	err := client.FillElevation(context.Background(), locs)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Nil(t, locs[0].Elevation)
+}
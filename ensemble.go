@@ -0,0 +1,353 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// EnsembleModel selects an ensemble prediction system for the Ensemble API.
+type EnsembleModel string
+
+// Ensemble models available from the Open-Meteo Ensemble API.
+const (
+	// DWD ICON
+	EnsembleICONSeamless EnsembleModel = "icon_seamless"
+	EnsembleICONGlobal   EnsembleModel = "icon_global"
+	EnsembleICONEU       EnsembleModel = "icon_eu"
+	EnsembleICOND2       EnsembleModel = "icon_d2"
+
+	// NOAA GFS
+	EnsembleGFSSeamless EnsembleModel = "gfs_seamless"
+	EnsembleGFS025      EnsembleModel = "gfs025"
+	EnsembleGFS05       EnsembleModel = "gfs05"
+
+	// ECMWF
+	EnsembleECMWFIFS025  EnsembleModel = "ecmwf_ifs025"
+	EnsembleECMWFAIFS025 EnsembleModel = "ecmwf_aifs025"
+
+	// Environment Canada GEM
+	EnsembleGEMGlobal EnsembleModel = "gem_global"
+
+	// Australian Bureau of Meteorology
+	EnsembleBOMAccessGlobal EnsembleModel = "bom_access_global_ensemble"
+
+	// UK Met Office
+	EnsembleUKMOGlobal20km EnsembleModel = "ukmo_global_ensemble_20km"
+	EnsembleUKMOUK2km      EnsembleModel = "ukmo_uk_ensemble_2km"
+
+	// MeteoSwiss ICON
+	EnsembleMeteoSwissICONCH1 EnsembleModel = "meteoswiss_icon_ch1"
+	EnsembleMeteoSwissICONCH2 EnsembleModel = "meteoswiss_icon_ch2"
+)
+
+// String returns the API parameter string for the model.
+func (m EnsembleModel) String() string {
+	return string(m)
+}
+
+// EnsembleRequest represents a request to the Ensemble API.
+type EnsembleRequest struct {
+	location Location
+	models   []EnsembleModel
+
+	// Metrics to request
+	hourlyMetrics []HourlyMetric
+
+	// Units
+	temperatureUnit   TemperatureUnit
+	windSpeedUnit     WindSpeedUnit
+	precipitationUnit PrecipitationUnit
+
+	// Time options
+	timezone      string
+	forecastDays  int
+	pastDays      int
+	forecastHours int
+	pastHours     int
+
+	// Date range options
+	startDate string
+	endDate   string
+
+	// Other options
+	cellSelection CellSelection
+}
+
+// NewEnsembleRequest creates a new EnsembleRequest for the given coordinates.
+// At least one ensemble model is required.
+func NewEnsembleRequest(lat, lon float64, models ...EnsembleModel) (*EnsembleRequest, error) {
+	if len(models) == 0 {
+		return nil, fmt.Errorf("at least one ensemble model is required")
+	}
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &EnsembleRequest{
+		location: loc,
+		models:   slices.Clone(models),
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *EnsembleRequest) WithLocation(loc Location) *EnsembleRequest {
+	r.location = loc
+	return r
+}
+
+// WithModels adds ensemble models to the request.
+func (r *EnsembleRequest) WithModels(models ...EnsembleModel) *EnsembleRequest {
+	r.models = append(r.models, models...)
+	return r
+}
+
+// WithHourly adds hourly metrics to the request.
+// Not every metric is available from every ensemble model.
+func (r *EnsembleRequest) WithHourly(metrics ...HourlyMetric) *EnsembleRequest {
+	r.hourlyMetrics = append(r.hourlyMetrics, metrics...)
+	return r
+}
+
+// WithTemperatureUnit sets the temperature unit.
+func (r *EnsembleRequest) WithTemperatureUnit(unit TemperatureUnit) *EnsembleRequest {
+	r.temperatureUnit = unit
+	return r
+}
+
+// WithWindSpeedUnit sets the wind speed unit.
+func (r *EnsembleRequest) WithWindSpeedUnit(unit WindSpeedUnit) *EnsembleRequest {
+	r.windSpeedUnit = unit
+	return r
+}
+
+// WithPrecipitationUnit sets the precipitation unit.
+func (r *EnsembleRequest) WithPrecipitationUnit(unit PrecipitationUnit) *EnsembleRequest {
+	r.precipitationUnit = unit
+	return r
+}
+
+// WithTimezone sets the timezone for the response.
+// Use "auto" to automatically detect timezone from coordinates.
+func (r *EnsembleRequest) WithTimezone(tz string) *EnsembleRequest {
+	r.timezone = tz
+	return r
+}
+
+// WithForecastDays sets the number of forecast days (0-35).
+func (r *EnsembleRequest) WithForecastDays(days int) *EnsembleRequest {
+	r.forecastDays = days
+	return r
+}
+
+// WithPastDays sets the number of past days to include.
+func (r *EnsembleRequest) WithPastDays(days int) *EnsembleRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithForecastHours sets the number of forecast hours.
+func (r *EnsembleRequest) WithForecastHours(hours int) *EnsembleRequest {
+	r.forecastHours = hours
+	return r
+}
+
+// WithPastHours sets the number of past hours to include.
+func (r *EnsembleRequest) WithPastHours(hours int) *EnsembleRequest {
+	r.pastHours = hours
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *EnsembleRequest) WithDateRange(startDate, endDate string) *EnsembleRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+func (r *EnsembleRequest) WithCellSelection(selection CellSelection) *EnsembleRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// modelNames returns the requested models as strings.
+func (r *EnsembleRequest) modelNames() []string {
+	names := make([]string, len(r.models))
+	for i, m := range r.models {
+		names[i] = string(m)
+	}
+	return names
+}
+
+// buildURL builds the URL for an ensemble request.
+func (r *EnsembleRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Models and metrics
+	params.Set("models", joinMetrics(r.models))
+	if len(r.hourlyMetrics) > 0 {
+		params.Set("hourly", joinMetrics(r.hourlyMetrics))
+	}
+
+	// Units
+	if r.temperatureUnit != "" {
+		params.Set("temperature_unit", string(r.temperatureUnit))
+	}
+	if r.windSpeedUnit != "" {
+		params.Set("wind_speed_unit", string(r.windSpeedUnit))
+	}
+	if r.precipitationUnit != "" {
+		params.Set("precipitation_unit", string(r.precipitationUnit))
+	}
+
+	// Time options
+	if r.timezone != "" {
+		params.Set("timezone", r.timezone)
+	}
+	if r.forecastDays > 0 {
+		params.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+	if r.forecastHours > 0 {
+		params.Set("forecast_hours", strconv.Itoa(r.forecastHours))
+	}
+	if r.pastHours > 0 {
+		params.Set("past_hours", strconv.Itoa(r.pastHours))
+	}
+
+	// Date range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+
+	// Other options
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// Ensemble contains the response from the Ensemble API.
+type Ensemble struct {
+	// Location information
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// Hourly contains the hourly data of each requested model.
+	Hourly map[EnsembleModel]*EnsembleHourlyData `json:"-"` // parsed separately
+}
+
+// EnsembleHourlyData contains the hourly ensemble data of a single model.
+// Missing data points are stored as NaN; see Series.
+type EnsembleHourlyData struct {
+	// Time contains timestamps for each data point.
+	Times []time.Time
+
+	// Variables contains the members of each returned variable.
+	Variables map[HourlyMetric]EnsembleMembers
+
+	// Units contains the unit string of each returned variable.
+	Units map[HourlyMetric]string
+}
+
+// Members returns the ensemble members of a variable, or nil if the
+// variable was not returned.
+func (d *EnsembleHourlyData) Members(metric HourlyMetric) EnsembleMembers {
+	if d == nil {
+		return nil
+	}
+	return d.Variables[metric]
+}
+
+// rawEnsembleResponse represents the raw JSON response from the Ensemble API.
+type rawEnsembleResponse struct {
+	rawMeta
+
+	Hourly      map[string]json.RawMessage `json:"hourly,omitempty"`
+	HourlyUnits map[string]string          `json:"hourly_units,omitempty"`
+}
+
+// parseEnsembleResponse parses the Ensemble API response into an Ensemble
+// struct. Models are needed to split variables when several were requested.
+func parseEnsembleResponse(body []byte, models []string) (*Ensemble, error) {
+	var raw rawEnsembleResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	ensemble := &Ensemble{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Elevation:            raw.Elevation,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+	}
+
+	if len(raw.Hourly) == 0 {
+		return ensemble, nil
+	}
+
+	times, err := parseFieldTimes(raw.Hourly, raw.timeLocation(), parseDateTimeArray)
+	if err != nil {
+		return nil, &DecodeError{Block: "hourly", Err: err}
+	}
+
+	units := splitModelFields(raw.HourlyUnits, models)
+	ensemble.Hourly = make(map[EnsembleModel]*EnsembleHourlyData, len(models))
+	for model, fields := range splitModelFields(raw.Hourly, models) {
+		variables, variableUnits, err := parseMemberBlock[HourlyMetric](fields, units[model])
+		if err != nil {
+			return nil, &DecodeError{Block: "hourly", Model: Model(model), Err: err}
+		}
+		ensemble.Hourly[EnsembleModel(model)] = &EnsembleHourlyData{
+			Times:     times,
+			Variables: variables,
+			Units:     variableUnits,
+		}
+	}
+
+	return ensemble, nil
+}
+
+// Ensemble retrieves ensemble forecast data for the given request.
+func (c *Client) Ensemble(ctx context.Context, req *EnsembleRequest) (*Ensemble, error) {
+	url := req.buildURL(c.ensembleURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseEnsembleResponse(body, req.modelNames())
+}
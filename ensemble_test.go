@@ -0,0 +1,125 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnsembleRequestRequiresModel(t *testing.T) {
+	_, err := NewEnsembleRequest(52.52, 13.41)
+	assert.Error(t, err)
+}
+
+func TestEnsembleRequestURL(t *testing.T) {
+	req, err := NewEnsembleRequest(52.52, 13.41, EnsembleICONSeamless)
+	require.NoError(t, err)
+
+	req.WithModels(EnsembleECMWFIFS025).
+		WithHourly(HourlyTemperature2m, HourlyPrecipitation).
+		WithTemperatureUnit(Fahrenheit).
+		WithForecastDays(10)
+
+	parsed, err := url.Parse(req.buildURL(ensembleBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "52.52", params.Get("latitude"))
+	assert.Equal(t, "ecmwf_ifs025,icon_seamless", params.Get("models"))
+	assert.Equal(t, "precipitation,temperature_2m", params.Get("hourly"))
+	assert.Equal(t, "fahrenheit", params.Get("temperature_unit"))
+	assert.Equal(t, "10", params.Get("forecast_days"))
+}
+
+func TestNewEnsembleRequestCopiesModels(t *testing.T) {
+	models := make([]EnsembleModel, 1, 2)
+	models[0] = EnsembleICONSeamless
+
+	req, err := NewEnsembleRequest(52.52, 13.41, models...)
+	require.NoError(t, err)
+	req.WithModels(EnsembleECMWFIFS025)
+
+	assert.Equal(t, EnsembleModel(""), models[:2][1], "caller's array is not modified")
+	assert.Equal(t, []EnsembleModel{EnsembleICONSeamless, EnsembleECMWFIFS025}, req.models)
+}
+
+func TestParseEnsemble(t *testing.T) {
+	data, err := os.ReadFile("testdata/ensemble.json")
+	require.NoError(t, err)
+
+	ensemble, err := parseEnsembleResponse(data, []string{"icon_seamless", "gfs_seamless"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 38.0, ensemble.Elevation)
+	require.Len(t, ensemble.Hourly, 2)
+
+	icon := ensemble.Hourly[EnsembleICONSeamless]
+	require.NotNil(t, icon)
+	require.Len(t, icon.Times, 3)
+	assert.Equal(t, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), icon.Times[1].UTC())
+
+	temp := icon.Members(HourlyTemperature2m)
+	require.Len(t, temp, 3)
+	assert.Equal(t, Series{1, 2, 3}, temp[0])
+	assert.Equal(t, 3.0, temp[2][0])
+	assert.Equal(t, Series{2, 3, 3}, temp.Mean())
+	assert.Equal(t, "°C", icon.Units[HourlyTemperature2m])
+	assert.Nil(t, icon.Members(HourlyPrecipitation))
+
+	gfs := ensemble.Hourly[EnsembleGFSSeamless]
+	require.NotNil(t, gfs)
+	assert.Len(t, gfs.Members(HourlyTemperature2m), 2)
+	assert.Equal(t, Series{0, 0.2, 0.1}, gfs.Members(HourlyPrecipitation)[0])
+	assert.Equal(t, "mm", gfs.Units[HourlyPrecipitation])
+}
+
+func TestParseEnsembleSingleModel(t *testing.T) {
+	body := []byte(`{"hourly":{"time":["2024-01-01T00:00"],"temperature_2m":[1.0],"temperature_2m_member01":[2.0]}}`)
+
+	ensemble, err := parseEnsembleResponse(body, []string{"icon_d2"})
+	require.NoError(t, err)
+
+	members := ensemble.Hourly[EnsembleICOND2].Members(HourlyTemperature2m)
+	require.Len(t, members, 2)
+	assert.Equal(t, Series{1.5}, members.Mean())
+}
+
+func TestParseEnsembleMemberOutOfRange(t *testing.T) {
+	body := []byte(`{"hourly":{"time":["2024-01-01T00:00"],"temperature_2m":[1.0],"temperature_2m_member999999999":[2.0]}}`)
+
+	_, err := parseEnsembleResponse(body, []string{"icon_d2"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrDecode)
+	var decodeErr *DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "hourly", decodeErr.Block)
+	assert.Contains(t, err.Error(), "temperature_2m_member999999999")
+}
+
+func TestClientEnsemble(t *testing.T) {
+	data, err := os.ReadFile("testdata/ensemble.json")
+	require.NoError(t, err)
+
+	var requested *http.Request
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req
+		return newMockResponse(http.StatusOK, data), nil
+	})
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewEnsembleRequest(52.52, 13.41, EnsembleICONSeamless, EnsembleGFSSeamless)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	ensemble, err := client.Ensemble(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ensemble-api.open-meteo.com", requested.URL.Host)
+	assert.Len(t, ensemble.Hourly, 2)
+}
@@ -1,11 +1,42 @@
 package omgo
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for use with errors.Is.
+var (
+	// ErrRateLimited matches an APIError with status 429. The wait requested
+	// by the API, if any, is in APIError.RetryAfter.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrInvalidParameter matches an APIError with status 400. The offending
+	// parameter, if it could be determined, is in APIError.Parameter.
+	ErrInvalidParameter = errors.New("invalid parameter")
+
+	// ErrServer matches an APIError with a 5xx status.
+	ErrServer = errors.New("server error")
+
+	// ErrDecode matches a DecodeError.
+	ErrDecode = errors.New("decoding response")
+)
 
 // APIError represents an error returned by the Open-Meteo API.
 type APIError struct {
 	StatusCode int
 	Reason     string
+
+	// RetryAfter is the wait requested by the Retry-After header, if any.
+	RetryAfter time.Duration
+
+	// Parameter is the request parameter named in the reason of a 400
+	// response, such as "hourly" or "latitude", if any.
+	Parameter string
 }
 
 // Error implements the error interface.
@@ -13,8 +44,76 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("open-meteo error %d: %s", e.StatusCode, e.Reason)
 }
 
+// Is reports whether the error matches ErrRateLimited, ErrInvalidParameter
+// or ErrServer.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrInvalidParameter:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrServer:
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	}
+	return false
+}
+
 // apiErrorResponse represents the JSON structure of an API error response.
 type apiErrorResponse struct {
 	Error  bool   `json:"error"`
 	Reason string `json:"reason"`
 }
+
+// Patterns of API reasons naming the offending parameter, e.g.
+// "Cannot initialize ... from invalid String value foo for key hourly",
+// "Parameter 'latitude' and 'longitude' must have the same number of elements"
+// and "Latitude must be in range of -90 to 90°. Given: 91.0."
+var parameterPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`for key (\w+)`),
+	regexp.MustCompile(`[Pp]arameter '?(\w+)'?`),
+	regexp.MustCompile(`^(\w+) must`),
+}
+
+// parseParameter returns the parameter named in an error reason, or "".
+func parseParameter(reason string) string {
+	for _, p := range parameterPatterns {
+		if m := p.FindStringSubmatch(reason); m != nil {
+			return strings.ToLower(m[1])
+		}
+	}
+	return ""
+}
+
+// DecodeError is returned when a response cannot be decoded. It matches
+// ErrDecode and wraps the underlying error.
+type DecodeError struct {
+	// Block is the block that failed, such as "hourly" or "daily_units",
+	// or "" if the response as a whole could not be decoded.
+	Block string
+
+	// Model is the model whose block failed, for multi-model responses.
+	Model Model
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	switch {
+	case e.Block != "" && e.Model != "":
+		return fmt.Sprintf("decoding %s of model %s: %v", e.Block, e.Model, e.Err)
+	case e.Block != "":
+		return fmt.Sprintf("decoding %s: %v", e.Block, e.Err)
+	}
+	return fmt.Sprintf("decoding response: %v", e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrDecode.
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
@@ -0,0 +1,110 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	assert.ErrorIs(t, &APIError{StatusCode: http.StatusTooManyRequests}, ErrRateLimited)
+	assert.ErrorIs(t, &APIError{StatusCode: http.StatusBadRequest}, ErrInvalidParameter)
+	assert.ErrorIs(t, &APIError{StatusCode: http.StatusBadGateway}, ErrServer)
+	assert.NotErrorIs(t, &APIError{StatusCode: http.StatusBadRequest}, ErrServer)
+	assert.NotErrorIs(t, &APIError{StatusCode: http.StatusNotFound}, ErrInvalidParameter)
+	assert.NotErrorIs(t, &APIError{StatusCode: http.StatusInternalServerError}, ErrDecode)
+}
+
+func TestParseParameter(t *testing.T) {
+	tests := []struct {
+		reason string
+		want   string
+	}{
+		{"Cannot initialize ForecastVariable from invalid String value tempeture_2m for key hourly", "hourly"},
+		{"Parameter 'latitude' and 'longitude' must have the same number of elements", "latitude"},
+		{"Latitude must be in range of -90 to 90°. Given: 91.0.", "latitude"},
+		{"Invalid timezone", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, parseParameter(tt.reason), tt.reason)
+	}
+}
+
+func TestClientInvalidParameter(t *testing.T) {
+	body := []byte(`{"error": true, "reason": "Cannot initialize ForecastVariable from invalid String value foo for key hourly"}`)
+	client := NewClient(WithHTTPClient(&mockHTTPClient{response: newMockResponse(http.StatusBadRequest, body)}))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyMetric("foo"))
+
+	_, err = client.Forecast(context.Background(), req)
+	require.ErrorIs(t, err, ErrInvalidParameter)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "hourly", apiErr.Parameter)
+}
+
+func TestParseDecodeErrors(t *testing.T) {
+	// Malformed document
+	_, err := parseWeatherResponse([]byte(`{"latitude": `))
+	require.ErrorIs(t, err, ErrDecode)
+	var decodeErr *DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "", decodeErr.Block)
+
+	// Malformed block
+	_, err = parseWeatherResponse([]byte(`{"hourly": {"time": ["2024-01-15T00:00"], "temperature_2m": "warm"}}`))
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "hourly", decodeErr.Block)
+	assert.Contains(t, err.Error(), "decoding hourly")
+
+	_, err = parseWeatherResponse([]byte(`{"daily": {"time": ["yesterday"]}}`))
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "daily", decodeErr.Block)
+
+	// Malformed block of one model
+	body := []byte(`{"hourly": {"time": ["2024-01-15T00:00"], "temperature_2m_icon_seamless": [1.0], "temperature_2m_ecmwf_ifs025": "cold"}}`)
+	_, err = parseWeatherResponse(body, ModelICONSeamless, ModelECMWFIFS025)
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "hourly", decodeErr.Block)
+	assert.Equal(t, ModelECMWFIFS025, decodeErr.Model)
+
+	// Multi-location responses keep the location in the message
+	_, err = parseMultiWeatherResponse([]byte(`[{}, {"current": {"time": 1}}]`), 2)
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "current", decodeErr.Block)
+	assert.Contains(t, err.Error(), "location 1")
+
+	// Location count mismatch
+	_, err = parseMultiWeatherResponse([]byte(`{}`), 2)
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "", decodeErr.Block)
+
+	// Other endpoints
+	_, err = parseMarineResponse([]byte(`{"daily": {"time": ["yesterday"]}}`))
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "daily", decodeErr.Block)
+
+	_, err = parseAirQualityResponse([]byte(`{"current": {"time": "now"}}`))
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "current", decodeErr.Block)
+
+	_, err = parseClimateResponse([]byte(`{"daily": {"time": ["yesterday"], "temperature_2m_max_EC_Earth3P_HR": [1.0]}}`), []string{"EC_Earth3P_HR"})
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "daily", decodeErr.Block)
+
+	// FlatBuffers
+	_, err = parseFlatBuffersResponse([]byte{1, 2}, testFlatBuffersVariables, 1, nil)
+	assert.ErrorIs(t, err, ErrDecode)
+
+	vars := testFlatBuffersVariables
+	vars.hourly = []string{"temperature_2m"}
+	_, err = parseFlatBuffersResponse(buildFlatBuffers(testFlatBuffersMessage(2.5)), vars, 1, nil)
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "hourly", decodeErr.Block)
+	assert.NotErrorIs(t, decodeErr.Err, ErrDecode, "not wrapped twice")
+}
@@ -0,0 +1,208 @@
+package omgo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Block identifies a time series block of a Weather response.
+type Block string
+
+const (
+	BlockHourly     Block = "hourly"
+	BlockMinutely15 Block = "minutely_15"
+	BlockDaily      Block = "daily"
+)
+
+// Time layouts used for exported timestamps (ISO8601 with the response's UTC offset).
+const (
+	exportDateTimeLayout = "2006-01-02T15:04:05Z07:00"
+	exportDateLayout     = "2006-01-02"
+)
+
+// Column is a returned variable of a Table. It holds either values or, for
+// daily sunrise and sunset, timestamps.
+type Column struct {
+	Name   string
+	Unit   string
+	Values Series
+	Times  []time.Time
+}
+
+// header returns the column header, with the unit in parentheses if known.
+func (c Column) header() string {
+	if c.Unit == "" {
+		return c.Name
+	}
+	return c.Name + " (" + c.Unit + ")"
+}
+
+// cell returns the text of row i, or "" if the value is missing.
+func (c Column) cell(i int) string {
+	if c.Times != nil {
+		if i >= len(c.Times) || c.Times[i].IsZero() {
+			return ""
+		}
+		return c.Times[i].Format(exportDateTimeLayout)
+	}
+	if c.Values.IsMissing(i) {
+		return ""
+	}
+	return strconv.FormatFloat(c.Values[i], 'f', -1, 64)
+}
+
+// Table is a block flattened into a time column and one column per returned
+// variable, in the order written by WriteCSV: known variables first, then
+// the variables in Extra.
+type Table struct {
+	Times   []time.Time
+	Columns []Column
+
+	timeLayout string
+}
+
+// headers returns the header row.
+func (t *Table) headers() []string {
+	row := make([]string, 0, len(t.Columns)+1)
+	row = append(row, "time")
+	for _, c := range t.Columns {
+		row = append(row, c.header())
+	}
+	return row
+}
+
+// row returns the text of row i.
+func (t *Table) row(i int) []string {
+	row := make([]string, 0, len(t.Columns)+1)
+	row = append(row, t.Times[i].Format(t.timeLayout))
+	for _, c := range t.Columns {
+		row = append(row, c.cell(i))
+	}
+	return row
+}
+
+// seriesColumns returns a column for every returned variable of a data block.
+func seriesColumns[M ~string](metrics []M, series func(M) (Series, bool), unit func(M) string) []Column {
+	columns := make([]Column, 0, len(metrics))
+	for _, m := range metrics {
+		s, _ := series(m)
+		columns = append(columns, Column{Name: string(m), Unit: unit(m), Values: s})
+	}
+	return columns
+}
+
+// table flattens a block for export, or returns nil if it was not returned.
+func (d *ModelData) table(block Block) (*Table, error) {
+	switch block {
+	case BlockHourly:
+		if d.Hourly == nil {
+			return nil, nil
+		}
+		return &Table{
+			Times:      d.Hourly.Times,
+			timeLayout: exportDateTimeLayout,
+			Columns:    seriesColumns(d.Hourly.Variables(), d.Hourly.Series, d.HourlyUnits.For),
+		}, nil
+	case BlockMinutely15:
+		if d.Minutely15 == nil {
+			return nil, nil
+		}
+		return &Table{
+			Times:      d.Minutely15.Times,
+			timeLayout: exportDateTimeLayout,
+			Columns:    seriesColumns(d.Minutely15.Variables(), d.Minutely15.Series, d.Minutely15Units.For),
+		}, nil
+	case BlockDaily:
+		if d.Daily == nil {
+			return nil, nil
+		}
+		columns := seriesColumns(d.Daily.Variables(), d.Daily.Series, d.DailyUnits.For)
+		if d.Daily.Sunrise != nil {
+			columns = append(columns, Column{Name: string(DailySunrise), Unit: d.DailyUnits.For(DailySunrise), Times: d.Daily.Sunrise})
+		}
+		if d.Daily.Sunset != nil {
+			columns = append(columns, Column{Name: string(DailySunset), Unit: d.DailyUnits.For(DailySunset), Times: d.Daily.Sunset})
+		}
+		return &Table{
+			Times:      d.Daily.Times,
+			timeLayout: exportDateLayout,
+			Columns:    columns,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown block %q", block)
+}
+
+// Table returns a block flattened into columns, as written by WriteCSV, or
+// an error if the block was not returned. It is the basis for exporting to
+// other formats.
+func (d *ModelData) Table(block Block) (*Table, error) {
+	table, err := d.table(block)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, fmt.Errorf("response has no %s data", block)
+	}
+	return table, nil
+}
+
+// WriteCSV writes a block as CSV: a time column followed by one column per
+// returned variable, with units in the header. Times are ISO8601 in the
+// response's timezone and missing values are left empty.
+func (d *ModelData) WriteCSV(out io.Writer, block Block) error {
+	table, err := d.Table(block)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(out)
+	if err := cw.Write(table.headers()); err != nil {
+		return err
+	}
+	for i := range table.Times {
+		if err := cw.Write(table.row(i)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteXLSX writes the returned hourly, 15-minutely and daily blocks as
+// sheets of an Excel workbook, with the same layout as WriteCSV.
+func (d *ModelData) WriteXLSX(out io.Writer) error {
+	var sheets []xlsxSheet
+	for _, block := range []Block{BlockHourly, BlockMinutely15, BlockDaily} {
+		table, err := d.table(block)
+		if err != nil {
+			return err
+		}
+		if table != nil {
+			sheets = append(sheets, xlsxSheet{name: string(block), table: table})
+		}
+	}
+	if len(sheets) == 0 {
+		return fmt.Errorf("response has no hourly, minutely_15 or daily data")
+	}
+	return writeXLSX(out, sheets)
+}
+
+// Table returns a block of the response flattened into columns; see
+// ModelData.Table. For multi-model responses, use ForModel instead.
+func (w *Weather) Table(block Block) (*Table, error) {
+	return w.data().Table(block)
+}
+
+// WriteCSV writes a block of the response as CSV; see ModelData.WriteCSV.
+// For multi-model responses, export each model via ForModel instead.
+func (w *Weather) WriteCSV(out io.Writer, block Block) error {
+	return w.data().WriteCSV(out, block)
+}
+
+// WriteXLSX writes the response as an Excel workbook; see ModelData.WriteXLSX.
+func (w *Weather) WriteXLSX(out io.Writer) error {
+	return w.data().WriteXLSX(out)
+}
@@ -0,0 +1,132 @@
+package omgo
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCSVHourly(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, weather.WriteCSV(&buf, BlockHourly))
+
+	expected := "time,temperature_2m (°C),relative_humidity_2m (%),precipitation (mm),weather_code (wmo code),wind_speed_10m (km/h)\n" +
+		"2024-01-15T00:00:00+01:00,2.5,85,0,3,12.5\n" +
+		"2024-01-15T01:00:00+01:00,2.3,86,0.1,61,13.2\n" +
+		"2024-01-15T02:00:00+01:00,2.1,87,0,3,11.8\n"
+	assert.Equal(t, expected, buf.String())
+
+	// Block not in the response
+	assert.Error(t, weather.WriteCSV(&buf, BlockDaily))
+	assert.Error(t, weather.WriteCSV(&buf, Block("weekly")))
+}
+
+func TestWriteCSVDailyAndExtra(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_extra.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, weather.WriteCSV(&buf, BlockDaily))
+	expected := "time,temperature_2m_max (°C),cape_max (J/kg),sunrise (iso8601)\n" +
+		"2024-01-15,5.2,150,2024-01-15T08:15:00Z\n"
+	assert.Equal(t, expected, buf.String())
+
+	// Missing values are empty, unknown variables follow known ones
+	buf.Reset()
+	require.NoError(t, weather.WriteCSV(&buf, BlockHourly))
+	expected = "time,temperature_2m (°C),boundary_layer_height (m),convective_inhibition (J/kg)\n" +
+		"2024-01-15T00:00:00Z,2.5,450,10\n" +
+		"2024-01-15T01:00:00Z,2.3,520,\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestTable(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_models.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data, ModelECMWFIFS025, ModelICONSeamless)
+	require.NoError(t, err)
+
+	table, err := weather.ForModel(ModelECMWFIFS025).Table(BlockHourly)
+	require.NoError(t, err)
+	assert.Len(t, table.Times, 3)
+	require.NotEmpty(t, table.Columns)
+	assert.Equal(t, "temperature_2m", table.Columns[0].Name)
+	assert.Equal(t, "°C", table.Columns[0].Unit)
+	assert.Equal(t, 1.8, table.Columns[0].Values[1])
+
+	_, err = weather.ForModel(ModelECMWFIFS025).Table(BlockMinutely15)
+	assert.Error(t, err)
+	_, err = weather.Table(Block("weekly"))
+	assert.Error(t, err)
+}
+
+func TestWriteXLSX(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_extra.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, weather.WriteXLSX(&buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+
+	require.Contains(t, files, "[Content_Types].xml")
+	require.Contains(t, files, "_rels/.rels")
+	require.Contains(t, files, "xl/_rels/workbook.xml.rels")
+
+	// One sheet per returned block
+	workbook := files["xl/workbook.xml"]
+	assert.Contains(t, workbook, `<sheet name="hourly" sheetId="1" r:id="rId1"/>`)
+	assert.Contains(t, workbook, `<sheet name="minutely_15" sheetId="2" r:id="rId2"/>`)
+	assert.Contains(t, workbook, `<sheet name="daily" sheetId="3" r:id="rId3"/>`)
+
+	hourly := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, hourly, `<c r="B1" t="inlineStr"><is><t>temperature_2m (°C)</t></is></c>`)
+	assert.Contains(t, hourly, `<c r="A2" t="inlineStr"><is><t>2024-01-15T00:00:00Z</t></is></c>`)
+	assert.Contains(t, hourly, `<c r="B2"><v>2.5</v></c>`)
+	assert.NotContains(t, hourly, `r="D3"`) // missing value
+	assert.Equal(t, 3, strings.Count(hourly, "<row "))
+
+	daily := files["xl/worksheets/sheet3.xml"]
+	assert.Contains(t, daily, `<c r="D2" t="inlineStr"><is><t>2024-01-15T08:15:00Z</t></is></c>`)
+
+	// Nothing to export
+	assert.Error(t, (&Weather{}).WriteXLSX(&buf))
+}
+
+func TestXLSXColumn(t *testing.T) {
+	assert.Equal(t, "A", xlsxColumn(0))
+	assert.Equal(t, "Z", xlsxColumn(25))
+	assert.Equal(t, "AA", xlsxColumn(26))
+	assert.Equal(t, "AZ", xlsxColumn(51))
+	assert.Equal(t, "BA", xlsxColumn(52))
+}
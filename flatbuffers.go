@@ -0,0 +1,427 @@
+package omgo
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Vtable offsets of the fields used from the openmeteo_sdk FlatBuffers schema.
+const (
+	// WeatherApiResponse
+	fbLatitude             flatbuffers.VOffsetT = 4
+	fbLongitude            flatbuffers.VOffsetT = 6
+	fbElevation            flatbuffers.VOffsetT = 8
+	fbGenerationTime       flatbuffers.VOffsetT = 10
+	fbUTCOffsetSeconds     flatbuffers.VOffsetT = 16
+	fbTimezone             flatbuffers.VOffsetT = 18
+	fbTimezoneAbbreviation flatbuffers.VOffsetT = 20
+	fbCurrent              flatbuffers.VOffsetT = 22
+	fbDaily                flatbuffers.VOffsetT = 24
+	fbHourly               flatbuffers.VOffsetT = 26
+	fbMinutely15           flatbuffers.VOffsetT = 28
+
+	// VariablesWithTime
+	fbTime      flatbuffers.VOffsetT = 4
+	fbTimeEnd   flatbuffers.VOffsetT = 6
+	fbInterval  flatbuffers.VOffsetT = 8
+	fbVariables flatbuffers.VOffsetT = 10
+
+	// VariableWithValues
+	fbValue       flatbuffers.VOffsetT = 8
+	fbValues      flatbuffers.VOffsetT = 10
+	fbValuesInt64 flatbuffers.VOffsetT = 12
+)
+
+// weatherVariables lists the variables of each block of a request in the
+// order they were sent. FlatBuffers responses return variables in this order
+// and identify them by enum rather than by name, so decoding relies on the
+// order and checks each variable's identity where it is known.
+type weatherVariables struct {
+	current    []string
+	hourly     []string
+	minutely15 []string
+	daily      []string
+}
+
+// fbTable wraps a FlatBuffers table with accessors for optional fields.
+type fbTable struct {
+	flatbuffers.Table
+}
+
+// field returns the position of a field, or 0 if it is not set.
+func (t *fbTable) field(slot flatbuffers.VOffsetT) flatbuffers.UOffsetT {
+	o := t.Offset(slot)
+	if o == 0 {
+		return 0
+	}
+	return t.Pos + flatbuffers.UOffsetT(o)
+}
+
+func (t *fbTable) float32(slot flatbuffers.VOffsetT) float32 {
+	return t.GetFloat32Slot(slot, 0)
+}
+
+func (t *fbTable) string(slot flatbuffers.VOffsetT) string {
+	if pos := t.field(slot); pos != 0 {
+		return t.String(pos)
+	}
+	return ""
+}
+
+// table returns the sub-table stored in a field, or nil if it is not set.
+func (t *fbTable) table(slot flatbuffers.VOffsetT) *fbTable {
+	pos := t.field(slot)
+	if pos == 0 {
+		return nil
+	}
+	return &fbTable{flatbuffers.Table{Bytes: t.Bytes, Pos: t.Indirect(pos)}}
+}
+
+// vector returns the start and length of a vector field, or 0 and -1 if it
+// is not set.
+func (t *fbTable) vector(slot flatbuffers.VOffsetT) (flatbuffers.UOffsetT, int) {
+	o := flatbuffers.UOffsetT(t.Offset(slot))
+	if o == 0 {
+		return 0, -1
+	}
+	return t.Vector(o), t.VectorLen(o)
+}
+
+// tables returns the tables of a vector field.
+func (t *fbTable) tables(slot flatbuffers.VOffsetT) []*fbTable {
+	start, n := t.vector(slot)
+	if n < 0 {
+		return nil
+	}
+	tables := make([]*fbTable, n)
+	for i := range tables {
+		elem := start + flatbuffers.UOffsetT(i)*flatbuffers.SizeUOffsetT
+		tables[i] = &fbTable{flatbuffers.Table{Bytes: t.Bytes, Pos: t.Indirect(elem)}}
+	}
+	return tables
+}
+
+// floats returns a float vector field as a Series. The API encodes missing
+// values as NaN, matching Series.
+func (t *fbTable) floats(slot flatbuffers.VOffsetT) Series {
+	start, n := t.vector(slot)
+	if n < 0 {
+		return nil
+	}
+	s := make(Series, n)
+	for i := range s {
+		s[i] = float64(t.GetFloat32(start + flatbuffers.UOffsetT(i)*flatbuffers.SizeFloat32))
+	}
+	return s
+}
+
+// timestamps returns an int64 vector field of Unix timestamps as times in loc.
+func (t *fbTable) timestamps(slot flatbuffers.VOffsetT, loc *time.Location) []time.Time {
+	start, n := t.vector(slot)
+	if n < 0 {
+		return nil
+	}
+	times := make([]time.Time, n)
+	for i := range times {
+		times[i] = time.Unix(t.GetInt64(start+flatbuffers.UOffsetT(i)*flatbuffers.SizeInt64), 0).In(loc)
+	}
+	return times
+}
+
+// blockTimes returns the timestamps of a VariablesWithTime table.
+func (t *fbTable) blockTimes(loc *time.Location) []time.Time {
+	start := t.GetInt64Slot(fbTime, 0)
+	end := t.GetInt64Slot(fbTimeEnd, 0)
+	interval := int64(t.GetInt32Slot(fbInterval, 0))
+	if interval <= 0 || end <= start {
+		return nil
+	}
+	times := make([]time.Time, 0, (end-start)/interval)
+	for ts := start; ts < end; ts += interval {
+		times = append(times, time.Unix(ts, 0).In(loc))
+	}
+	return times
+}
+
+// blockVariables returns the variables of a VariablesWithTime table,
+// checking that they match the requested names.
+func (t *fbTable) blockVariables(names []string) ([]*fbTable, error) {
+	vars := t.tables(fbVariables)
+	if len(vars) != len(names) {
+		return nil, fmt.Errorf("expected %d variables, got %d", len(names), len(vars))
+	}
+	for i, v := range vars {
+		if id, ok := fbIdentityOf(names[i]); ok && !id.matches(v) {
+			return nil, fmt.Errorf("variable %d is not %s", i, names[i])
+		}
+	}
+	return vars, nil
+}
+
+// splitFlatBuffers splits a response into its size-prefixed messages.
+func splitFlatBuffers(body []byte) ([][]byte, error) {
+	var messages [][]byte
+	for len(body) > 0 {
+		if len(body) < flatbuffers.SizeUint32 {
+			return nil, fmt.Errorf("truncated message size")
+		}
+		size := int(binary.LittleEndian.Uint32(body))
+		body = body[flatbuffers.SizeUint32:]
+		if size > len(body) {
+			return nil, fmt.Errorf("truncated message: need %d bytes, have %d", size, len(body))
+		}
+		messages = append(messages, body[:size])
+		body = body[size:]
+	}
+	return messages, nil
+}
+
+// parseFlatBuffersResponse parses a FlatBuffers response into one Weather
+// per location. The API returns one message per location and model, ordered
+// by location and then by model in the order they were sent.
+func parseFlatBuffersResponse(body []byte, vars weatherVariables, expected int, models []Model) (weathers []*Weather, err error) {
+	// The FlatBuffers runtime panics on out-of-range offsets; report
+	// malformed messages as errors instead.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed flatbuffers message: %v", r)
+		}
+		var de *DecodeError
+		if err != nil && !errors.As(err, &de) {
+			weathers, err = nil, &DecodeError{Err: err}
+		}
+	}()
+
+	messages, err := splitFlatBuffers(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Models are sent sorted and deduplicated, and messages follow that order
+	sent := make([]Model, 0, len(models))
+	for _, m := range sortedMetrics(models) {
+		sent = append(sent, Model(m))
+	}
+
+	perLocation := max(len(sent), 1)
+	if len(messages) != expected*perLocation {
+		return nil, fmt.Errorf("expected %d messages, got %d", expected*perLocation, len(messages))
+	}
+
+	weathers = make([]*Weather, expected)
+	for i := range weathers {
+		weather, err := parseFlatBuffersLocation(messages[i*perLocation:(i+1)*perLocation], vars, sent)
+		if err != nil {
+			return nil, fmt.Errorf("parsing location %d: %w", i, err)
+		}
+		weathers[i] = weather
+	}
+	return weathers, nil
+}
+
+// parseFlatBuffersLocation parses the messages of a single location, one per model.
+func parseFlatBuffersLocation(messages [][]byte, vars weatherVariables, models []Model) (*Weather, error) {
+	data := make([]*ModelData, len(messages))
+	var weather *Weather
+	for i, msg := range messages {
+		root := &fbTable{flatbuffers.Table{Bytes: msg, Pos: flatbuffers.GetUOffsetT(msg)}}
+
+		if weather == nil {
+			weather = &Weather{
+				Latitude:             float64(root.float32(fbLatitude)),
+				Longitude:            float64(root.float32(fbLongitude)),
+				Elevation:            float64(root.float32(fbElevation)),
+				Timezone:             root.string(fbTimezone),
+				TimezoneAbbreviation: root.string(fbTimezoneAbbreviation),
+				UTCOffsetSeconds:     int(root.GetInt32Slot(fbUTCOffsetSeconds, 0)),
+				GenerationTimeMs:     float64(root.float32(fbGenerationTime)),
+			}
+		}
+
+		var model Model
+		if len(models) > 1 {
+			model = models[i]
+		}
+		md, err := parseFlatBuffersModel(root, vars, model, rawMeta{Timezone: weather.Timezone}.timeLocation())
+		if err != nil {
+			return nil, err
+		}
+		data[i] = md
+	}
+
+	// Without several models the data is the response itself
+	if len(models) <= 1 {
+		weather.Current = data[0].Current
+		weather.CurrentUnits = data[0].CurrentUnits
+		weather.Hourly = data[0].Hourly
+		weather.HourlyUnits = data[0].HourlyUnits
+		weather.Minutely15 = data[0].Minutely15
+		weather.Minutely15Units = data[0].Minutely15Units
+		weather.Daily = data[0].Daily
+		weather.DailyUnits = data[0].DailyUnits
+		if len(models) == 1 {
+			weather.Models = map[Model]*ModelData{models[0]: data[0]}
+		}
+		return weather, nil
+	}
+
+	// Mirror the JSON layout: shared timestamps at the top level, the data
+	// of each model in Models.
+	weather.Models = make(map[Model]*ModelData, len(models))
+	for i, m := range models {
+		weather.Models[m] = data[i]
+	}
+	first := data[0]
+	if first.Current != nil {
+		weather.Current = &CurrentData{Time: first.Current.Time, Interval: first.Current.Interval}
+	}
+	if first.Hourly != nil {
+		weather.Hourly = &HourlyData{BaseMetrics: BaseMetrics{Times: first.Hourly.Times}}
+	}
+	if first.Minutely15 != nil {
+		weather.Minutely15 = &Minutely15Data{BaseMetrics: BaseMetrics{Times: first.Minutely15.Times}}
+	}
+	if first.Daily != nil {
+		weather.Daily = &DailyData{Times: first.Daily.Times}
+	}
+	return weather, nil
+}
+
+// parseFlatBuffersModel parses the data and unit blocks of a single message.
+// The model is reported in errors and is empty for single-model responses.
+func parseFlatBuffersModel(root *fbTable, names weatherVariables, model Model, loc *time.Location) (*ModelData, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	md := &ModelData{}
+
+	if block := root.table(fbCurrent); block != nil {
+		vars, err := block.blockVariables(names.current)
+		if err != nil {
+			return nil, &DecodeError{Block: "current", Model: model, Err: err}
+		}
+		md.Current = parseFlatBuffersCurrent(block, vars, names.current, loc)
+		md.CurrentUnits = &CurrentUnits{Time: "iso8601", Interval: "seconds"}
+		md.CurrentUnits.Extra = parseFlatBuffersUnits(vars, names.current, md.CurrentUnits, currentUnitFields())
+	}
+	if block := root.table(fbHourly); block != nil {
+		vars, err := block.blockVariables(names.hourly)
+		if err != nil {
+			return nil, &DecodeError{Block: "hourly", Model: model, Err: err}
+		}
+		md.Hourly = &HourlyData{}
+		md.Hourly.Times = block.blockTimes(loc)
+		md.Hourly.Extra = parseFlatBuffersSeries(vars, names.hourly, md.Hourly, hourlyFields())
+		md.HourlyUnits = &HourlyUnits{}
+		md.HourlyUnits.Extra = parseFlatBuffersUnits(vars, names.hourly, md.HourlyUnits, hourlyUnitFields())
+	}
+	if block := root.table(fbMinutely15); block != nil {
+		vars, err := block.blockVariables(names.minutely15)
+		if err != nil {
+			return nil, &DecodeError{Block: "minutely_15", Model: model, Err: err}
+		}
+		md.Minutely15 = &Minutely15Data{}
+		md.Minutely15.Times = block.blockTimes(loc)
+		md.Minutely15.Extra = parseFlatBuffersSeries(vars, names.minutely15, md.Minutely15, minutely15Fields())
+		md.Minutely15Units = &Minutely15Units{}
+		md.Minutely15Units.Extra = parseFlatBuffersUnits(vars, names.minutely15, md.Minutely15Units, minutely15UnitFields())
+	}
+	if block := root.table(fbDaily); block != nil {
+		vars, err := block.blockVariables(names.daily)
+		if err != nil {
+			return nil, &DecodeError{Block: "daily", Model: model, Err: err}
+		}
+		md.Daily = parseFlatBuffersDaily(block, vars, names.daily, loc)
+		md.DailyUnits = &DailyUnits{}
+		md.DailyUnits.Extra = parseFlatBuffersUnits(vars, names.daily, md.DailyUnits, dailyUnitFields())
+	}
+	return md, nil
+}
+
+// parseFlatBuffersSeries stores the variables of a block in the fields of dst,
+// returning the variables that have no field.
+func parseFlatBuffersSeries(vars []*fbTable, names []string, dst any, idx fieldIndex) map[string]Series {
+	var extra map[string]Series
+	for i, v := range vars {
+		s := v.floats(fbValues)
+		if idx.setSeries(dst, names[i], s) {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]Series)
+		}
+		extra[names[i]] = s
+	}
+	return extra
+}
+
+// parseFlatBuffersUnits stores the unit of every variable of a block in the
+// fields of the units struct pointed to by dst, returning the units of the
+// variables that have no field. Sunrise and sunset are decoded as times, so
+// their unit is reported as in JSON responses.
+func parseFlatBuffersUnits(vars []*fbTable, names []string, dst any, idx fieldIndex) map[string]string {
+	var extra map[string]string
+	for i, v := range vars {
+		unit := v.unit()
+		if names[i] == string(DailySunrise) || names[i] == string(DailySunset) {
+			unit = "iso8601"
+		}
+		if idx.setStr(dst, names[i], unit) {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]string)
+		}
+		extra[names[i]] = unit
+	}
+	return extra
+}
+
+// parseFlatBuffersDaily parses a daily block. Sunrise and sunset are sent
+// as Unix timestamps rather than values.
+func parseFlatBuffersDaily(block *fbTable, vars []*fbTable, names []string, loc *time.Location) *DailyData {
+	daily := &DailyData{Times: block.blockTimes(loc)}
+	for i, v := range vars {
+		switch names[i] {
+		case string(DailySunrise):
+			daily.Sunrise = v.timestamps(fbValuesInt64, loc)
+		case string(DailySunset):
+			daily.Sunset = v.timestamps(fbValuesInt64, loc)
+		default:
+			s := v.floats(fbValues)
+			if dailyFields().setSeries(daily, names[i], s) {
+				continue
+			}
+			if daily.Extra == nil {
+				daily.Extra = make(map[string]Series)
+			}
+			daily.Extra[names[i]] = s
+		}
+	}
+	return daily
+}
+
+// parseFlatBuffersCurrent parses a current block, which holds a single
+// value per variable.
+func parseFlatBuffersCurrent(block *fbTable, vars []*fbTable, names []string, loc *time.Location) *CurrentData {
+	current := &CurrentData{
+		Time:     time.Unix(block.GetInt64Slot(fbTime, 0), 0).In(loc),
+		Interval: int(block.GetInt32Slot(fbInterval, 0)),
+	}
+	for i, v := range vars {
+		value := float64(v.float32(fbValue))
+		if math.IsNaN(value) || currentFields().setValue(current, names[i], value) {
+			continue
+		}
+		if current.Extra == nil {
+			current.Extra = make(map[string]float64)
+		}
+		current.Extra[names[i]] = value
+	}
+	return current
+}
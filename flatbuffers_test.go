@@ -0,0 +1,335 @@
+package omgo
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fbTestVariable is a VariableWithValues for building test messages.
+type fbTestVariable struct {
+	variable    byte
+	unit        byte
+	altitude    int16
+	value       float32
+	values      []float32
+	valuesInt64 []int64
+}
+
+// fbTestBlock is a VariablesWithTime for building test messages.
+type fbTestBlock struct {
+	start, end int64
+	interval   int32
+	variables  []fbTestVariable
+}
+
+// fbTestMessage is a WeatherApiResponse for building test messages.
+type fbTestMessage struct {
+	latitude, longitude, elevation float32
+	utcOffsetSeconds               int32
+	timezone, abbreviation         string
+	current, hourly, daily         *fbTestBlock
+	minutely15                     *fbTestBlock
+}
+
+// fbSlot converts a vtable offset to the builder's field slot.
+func fbSlot(o flatbuffers.VOffsetT) int {
+	return int(o-4) / 2
+}
+
+// buildFlatBuffers encodes the messages as a size-prefixed FlatBuffers stream,
+// as returned by the API.
+func buildFlatBuffers(messages ...fbTestMessage) []byte {
+	var out []byte
+	for _, m := range messages {
+		b := flatbuffers.NewBuilder(256)
+
+		blocks := map[flatbuffers.VOffsetT]flatbuffers.UOffsetT{}
+		for slot, block := range map[flatbuffers.VOffsetT]*fbTestBlock{
+			fbCurrent: m.current, fbHourly: m.hourly, fbDaily: m.daily, fbMinutely15: m.minutely15,
+		} {
+			if block != nil {
+				blocks[slot] = buildFlatBuffersBlock(b, block)
+			}
+		}
+		timezone := b.CreateString(m.timezone)
+		abbreviation := b.CreateString(m.abbreviation)
+
+		b.StartObject(14)
+		b.PrependFloat32Slot(fbSlot(fbLatitude), m.latitude, 0)
+		b.PrependFloat32Slot(fbSlot(fbLongitude), m.longitude, 0)
+		b.PrependFloat32Slot(fbSlot(fbElevation), m.elevation, 0)
+		b.PrependFloat32Slot(fbSlot(fbGenerationTime), 0.5, 0)
+		b.PrependInt32Slot(fbSlot(fbUTCOffsetSeconds), m.utcOffsetSeconds, 0)
+		b.PrependUOffsetTSlot(fbSlot(fbTimezone), timezone, 0)
+		b.PrependUOffsetTSlot(fbSlot(fbTimezoneAbbreviation), abbreviation, 0)
+		for slot, block := range blocks {
+			b.PrependUOffsetTSlot(fbSlot(slot), block, 0)
+		}
+		b.FinishSizePrefixed(b.EndObject())
+
+		out = append(out, b.FinishedBytes()...)
+	}
+	return out
+}
+
+func buildFlatBuffersBlock(b *flatbuffers.Builder, block *fbTestBlock) flatbuffers.UOffsetT {
+	vars := make([]flatbuffers.UOffsetT, len(block.variables))
+	for i, v := range block.variables {
+		var values, valuesInt64 flatbuffers.UOffsetT
+		if v.values != nil {
+			b.StartVector(flatbuffers.SizeFloat32, len(v.values), flatbuffers.SizeFloat32)
+			for j := len(v.values) - 1; j >= 0; j-- {
+				b.PrependFloat32(v.values[j])
+			}
+			values = b.EndVector(len(v.values))
+		}
+		if v.valuesInt64 != nil {
+			b.StartVector(flatbuffers.SizeInt64, len(v.valuesInt64), flatbuffers.SizeInt64)
+			for j := len(v.valuesInt64) - 1; j >= 0; j-- {
+				b.PrependInt64(v.valuesInt64[j])
+			}
+			valuesInt64 = b.EndVector(len(v.valuesInt64))
+		}
+
+		b.StartObject(12)
+		b.PrependByteSlot(fbSlot(fbVariable), v.variable, 0)
+		b.PrependByteSlot(fbSlot(fbUnit), v.unit, 0)
+		b.PrependInt16Slot(fbSlot(fbAltitude), v.altitude, 0)
+		b.PrependFloat32Slot(fbSlot(fbValue), v.value, 0)
+		if values != 0 {
+			b.PrependUOffsetTSlot(fbSlot(fbValues), values, 0)
+		}
+		if valuesInt64 != 0 {
+			b.PrependUOffsetTSlot(fbSlot(fbValuesInt64), valuesInt64, 0)
+		}
+		vars[i] = b.EndObject()
+	}
+
+	b.StartVector(flatbuffers.SizeUOffsetT, len(vars), flatbuffers.SizeUOffsetT)
+	for i := len(vars) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(vars[i])
+	}
+	variables := b.EndVector(len(vars))
+
+	b.StartObject(4)
+	b.PrependInt64Slot(fbSlot(fbTime), block.start, 0)
+	b.PrependInt64Slot(fbSlot(fbTimeEnd), block.end, 0)
+	b.PrependInt32Slot(fbSlot(fbInterval), block.interval, 0)
+	b.PrependUOffsetTSlot(fbSlot(fbVariables), variables, 0)
+	return b.EndObject()
+}
+
+// 2024-01-15T00:00 UTC
+const fbTestStart = 1705276800
+
+func testFlatBuffersMessage(temperature float32) fbTestMessage {
+	nan := float32(math.NaN())
+	return fbTestMessage{
+		latitude:     52.5,
+		longitude:    13.25,
+		elevation:    38,
+		timezone:     "GMT",
+		abbreviation: "GMT",
+		current: &fbTestBlock{
+			start:    fbTestStart + 14*3600,
+			interval: 900,
+			variables: []fbTestVariable{
+				{variable: 19, unit: 6, value: 1},                        // is_day
+				{variable: 47, unit: 1, altitude: 2, value: temperature}, // temperature_2m
+			},
+		},
+		hourly: &fbTestBlock{
+			start:    fbTestStart,
+			end:      fbTestStart + 3*3600,
+			interval: 3600,
+			variables: []fbTestVariable{
+				{unit: 29, values: []float32{450, 500, 550}},                                    // boundary_layer_height
+				{variable: 47, unit: 1, altitude: 2, values: []float32{temperature, 2.25, nan}}, // temperature_2m
+				{variable: 56, unit: 40, values: []float32{3, 61, 3}},                           // weather_code
+			},
+		},
+		daily: &fbTestBlock{
+			start:    fbTestStart,
+			end:      fbTestStart + 86400,
+			interval: 86400,
+			variables: []fbTestVariable{
+				{variable: 40, unit: 37, valuesInt64: []int64{fbTestStart + 8*3600}}, // sunrise
+				{variable: 47, unit: 1, altitude: 2, values: []float32{5.5}},         // temperature_2m_max
+			},
+		},
+	}
+}
+
+var testFlatBuffersVariables = weatherVariables{
+	current: []string{"is_day", "temperature_2m"},
+	hourly:  []string{"boundary_layer_height", "temperature_2m", "weather_code"},
+	daily:   []string{"sunrise", "temperature_2m_max"},
+}
+
+func TestParseFlatBuffers(t *testing.T) {
+	body := buildFlatBuffers(testFlatBuffersMessage(2.5))
+
+	weathers, err := parseFlatBuffersResponse(body, testFlatBuffersVariables, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, weathers, 1)
+	weather := weathers[0]
+
+	assert.Equal(t, 52.5, weather.Latitude)
+	assert.Equal(t, 13.25, weather.Longitude)
+	assert.Equal(t, 38.0, weather.Elevation)
+	assert.Equal(t, "GMT", weather.Timezone)
+
+	// Current
+	require.NotNil(t, weather.Current)
+	assert.Equal(t, time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC), weather.Current.Time.UTC())
+	assert.Equal(t, 900, weather.Current.Interval)
+	require.NotNil(t, weather.Current.Temperature2m)
+	assert.Equal(t, 2.5, *weather.Current.Temperature2m)
+	assert.True(t, weather.Current.IsDaytime())
+
+	// Hourly
+	require.NotNil(t, weather.Hourly)
+	require.Len(t, weather.Hourly.Times, 3)
+	assert.Equal(t, time.Date(2024, 1, 15, 2, 0, 0, 0, time.UTC), weather.Hourly.Times[2].UTC())
+	assert.Equal(t, 2.5, weather.Hourly.Temperature2m[0])
+	assert.Equal(t, 2.25, weather.Hourly.Temperature2m[1])
+	assert.True(t, weather.Hourly.Temperature2m.IsMissing(2))
+	assert.Equal(t, WeatherCodes{3, 61, 3}, weather.Hourly.WeatherCode)
+	assert.Equal(t, map[string]Series{"boundary_layer_height": {450, 500, 550}}, weather.Hourly.Extra)
+
+	// Daily
+	require.NotNil(t, weather.Daily)
+	require.Len(t, weather.Daily.Times, 1)
+	assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), weather.Daily.Times[0].UTC())
+	require.Len(t, weather.Daily.Sunrise, 1)
+	assert.Equal(t, time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC), weather.Daily.Sunrise[0].UTC())
+	assert.Equal(t, Series{5.5}, weather.Daily.Temperature2mMax)
+
+	// Units
+	require.NotNil(t, weather.CurrentUnits)
+	assert.Equal(t, "°C", weather.CurrentUnits.Temperature2m)
+	assert.Equal(t, "iso8601", weather.CurrentUnits.Time)
+	require.NotNil(t, weather.HourlyUnits)
+	assert.Equal(t, "°C", weather.HourlyUnits.Temperature2m)
+	assert.Equal(t, "wmo code", weather.HourlyUnits.WeatherCode)
+	assert.Equal(t, "m", weather.HourlyUnits.For(HourlyMetric("boundary_layer_height")))
+	require.NotNil(t, weather.DailyUnits)
+	assert.Equal(t, "°C", weather.DailyUnits.Temperature2mMax)
+	assert.Equal(t, "iso8601", weather.DailyUnits.Sunrise)
+
+	// Not requested
+	assert.Nil(t, weather.Minutely15)
+	assert.Nil(t, weather.Models)
+}
+
+func TestParseFlatBuffersMultiLocationAndModels(t *testing.T) {
+	// Two locations
+	body := buildFlatBuffers(testFlatBuffersMessage(2.5), testFlatBuffersMessage(6.5))
+	weathers, err := parseFlatBuffersResponse(body, testFlatBuffersVariables, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, weathers, 2)
+	assert.Equal(t, 2.5, weathers[0].Hourly.Temperature2m[0])
+	assert.Equal(t, 6.5, weathers[1].Hourly.Temperature2m[0])
+
+	// Count mismatch
+	_, err = parseFlatBuffersResponse(body, testFlatBuffersVariables, 3, nil)
+	assert.Error(t, err)
+
+	// Two models: messages follow the sorted model names
+	weathers, err = parseFlatBuffersResponse(body, testFlatBuffersVariables, 1, []Model{ModelICONSeamless, ModelECMWFIFS025})
+	require.NoError(t, err)
+	require.Len(t, weathers, 1)
+	weather := weathers[0]
+	assert.Equal(t, 2.5, weather.ForModel(ModelECMWFIFS025).Hourly.Temperature2m[0])
+	assert.Equal(t, 6.5, weather.ForModel(ModelICONSeamless).Hourly.Temperature2m[0])
+	assert.Len(t, weather.Hourly.Times, 3)
+	assert.Empty(t, weather.Hourly.Temperature2m)
+}
+
+func TestParseFlatBuffersErrors(t *testing.T) {
+	body := buildFlatBuffers(testFlatBuffersMessage(2.5))
+
+	// Variables don't match the request
+	vars := testFlatBuffersVariables
+	vars.hourly = []string{"temperature_2m"}
+	_, err := parseFlatBuffersResponse(body, vars, 1, nil)
+	assert.Error(t, err)
+
+	// Variables in a different order than requested
+	vars.hourly = []string{"boundary_layer_height", "weather_code", "temperature_2m"}
+	_, err = parseFlatBuffersResponse(body, vars, 1, nil)
+	assert.ErrorContains(t, err, "variable 1 is not weather_code")
+
+	// Same variable at a different altitude
+	vars.hourly = []string{"boundary_layer_height", "temperature_80m", "weather_code"}
+	_, err = parseFlatBuffersResponse(body, vars, 1, nil)
+	assert.ErrorContains(t, err, "variable 1 is not temperature_80m")
+
+	// Truncated stream
+	_, err = parseFlatBuffersResponse(body[:len(body)-8], testFlatBuffersVariables, 1, nil)
+	assert.Error(t, err)
+
+	// Malformed message
+	_, err = parseFlatBuffersResponse([]byte{8, 0, 0, 0, 0xff, 0xff, 0xff, 0x7f, 0, 0, 0, 0}, testFlatBuffersVariables, 1, nil)
+	assert.Error(t, err)
+}
+
+func TestFlatBuffersIdentity(t *testing.T) {
+	tests := []struct {
+		name string
+		want fbIdentity
+	}{
+		{"temperature_2m", fbIdentity{variable: 47, level: fbAltitude, value: 2}},
+		{"wind_direction_10m_dominant", fbIdentity{variable: 57, level: fbAltitude, value: 10}},
+		{"temperature_850hPa", fbIdentity{variable: 47, level: fbPressureLevel, value: 850}},
+		{"soil_temperature_6cm", fbIdentity{variable: 44, level: fbDepth, value: 6}},
+		{"soil_moisture_1_to_3cm", fbIdentity{variable: 42, level: fbDepth, value: 1, depthTo: 3}},
+		{"precipitation_sum", fbIdentity{variable: 24}},
+		{"uv_index_clear_sky_max", fbIdentity{variable: 53}},
+		{"weather_code", fbIdentity{variable: 56}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := fbIdentityOf(tt.name)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+
+	_, ok := fbIdentityOf("boundary_layer_height")
+	assert.False(t, ok, "unknown variables are not checked")
+}
+
+func TestClientForecastFlatBuffers(t *testing.T) {
+	body := buildFlatBuffers(testFlatBuffersMessage(2.5))
+
+	var gotURL string
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		return newMockResponse(http.StatusOK, body), nil
+	})
+
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyWeatherCode, HourlyTemperature2m, HourlyMetric("boundary_layer_height")).
+		WithDaily(DailyTemperature2mMax, DailySunrise).
+		WithCurrent(CurrentTemperature2m, CurrentIsDay).
+		WithFormat(FormatFlatBuffers)
+
+	weather, err := client.Forecast(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Contains(t, gotURL, "format=flatbuffers")
+	require.NotNil(t, weather.Hourly)
+	assert.Equal(t, 2.5, weather.Hourly.Temperature2m[0])
+	assert.Equal(t, Series{5.5}, weather.Daily.Temperature2mMax)
+}
@@ -0,0 +1,218 @@
+package omgo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Vtable offsets of the VariableWithValues fields identifying a variable.
+const (
+	fbVariable      flatbuffers.VOffsetT = 4
+	fbUnit          flatbuffers.VOffsetT = 6
+	fbAltitude      flatbuffers.VOffsetT = 14
+	fbPressureLevel flatbuffers.VOffsetT = 18
+	fbDepth         flatbuffers.VOffsetT = 20
+	fbDepthTo       flatbuffers.VOffsetT = 22
+)
+
+// fbVariableCodes maps the base names of variables to their value in the
+// openmeteo_sdk Variable enum. Variables missing here, such as those unknown
+// to this package, are decoded without an identity check.
+var fbVariableCodes = map[string]byte{
+	"apparent_temperature":                 1,
+	"cape":                                 2,
+	"cloud_cover":                          3,
+	"cloud_cover_high":                     4,
+	"cloud_cover_low":                      5,
+	"cloud_cover_mid":                      6,
+	"daylight_duration":                    7,
+	"dew_point":                            8,
+	"diffuse_radiation":                    9,
+	"diffuse_radiation_instant":            10,
+	"direct_normal_irradiance":             11,
+	"direct_normal_irradiance_instant":     12,
+	"direct_radiation":                     13,
+	"direct_radiation_instant":             14,
+	"et0_fao_evapotranspiration":           15,
+	"evapotranspiration":                   16,
+	"freezing_level_height":                17,
+	"growing_degree_days":                  18,
+	"is_day":                               19,
+	"latent_heat_flux":                     20,
+	"leaf_wetness_probability":             21,
+	"lifted_index":                         22,
+	"lightning_potential":                  23,
+	"precipitation":                        24,
+	"precipitation_hours":                  25,
+	"precipitation_probability":            26,
+	"pressure_msl":                         27,
+	"rain":                                 28,
+	"relative_humidity":                    29,
+	"runoff":                               30,
+	"sensible_heat_flux":                   31,
+	"shortwave_radiation":                  32,
+	"shortwave_radiation_instant":          33,
+	"showers":                              34,
+	"snow_depth":                           35,
+	"snow_height":                          36,
+	"snowfall":                             37,
+	"snowfall_height":                      38,
+	"snowfall_water_equivalent":            39,
+	"sunrise":                              40,
+	"sunset":                               41,
+	"soil_moisture":                        42,
+	"soil_moisture_index":                  43,
+	"soil_temperature":                     44,
+	"surface_pressure":                     45,
+	"surface_temperature":                  46,
+	"temperature":                          47,
+	"terrestrial_radiation":                48,
+	"terrestrial_radiation_instant":        49,
+	"total_column_integrated_water_vapour": 50,
+	"updraft":                              51,
+	"uv_index":                             52,
+	"uv_index_clear_sky":                   53,
+	"vapour_pressure_deficit":              54,
+	"visibility":                           55,
+	"weather_code":                         56,
+	"wind_direction":                       57,
+	"wind_gusts":                           58,
+	"wind_speed":                           59,
+	"vertical_velocity":                    60,
+	"geopotential_height":                  61,
+	"wet_bulb_temperature":                 62,
+}
+
+// fbUnits maps the openmeteo_sdk Unit enum to the unit strings of JSON
+// responses.
+var fbUnits = [...]string{
+	"",          // undefined
+	"°C",        // celsius
+	"cm",        // centimetre
+	"m³/m³",     // cubic_metre_per_cubic_metre
+	"m³/s",      // cubic_metre_per_second
+	"°",         // degree_direction
+	"",          // dimensionless_integer
+	"",          // dimensionless
+	"EAQI",      // european_air_quality_index
+	"°F",        // fahrenheit
+	"ft",        // feet
+	"",          // fraction
+	"GDD °C",    // gdd_celsius
+	"m",         // geopotential_metre
+	"grains/m³", // grains_per_cubic_metre
+	"g/kg",      // gram_per_kilogram
+	"hPa",       // hectopascal
+	"h",         // hours
+	"inch",      // inch
+	"iso8601",   // iso8601
+	"J/kg",      // joule_per_kilogram
+	"K",         // kelvin
+	"kPa",       // kilopascal
+	"kg/m²",     // kilogram_per_square_metre
+	"km/h",      // kilometres_per_hour
+	"kn",        // knots
+	"MJ/m²",     // megajoule_per_square_metre
+	"m/s",       // metre_per_second_not_unit_converted
+	"m/s",       // metre_per_second
+	"m",         // metre
+	"μg/m³",     // micrograms_per_cubic_metre
+	"mp/h",      // miles_per_hour
+	"mm",        // millimetre
+	"Pa",        // pascal
+	"1/s",       // per_second
+	"%",         // percentage
+	"s",         // seconds
+	"unixtime",  // unix_time
+	"USAQI",     // us_air_quality_index
+	"W/m²",      // watt_per_square_metre
+	"wmo code",  // wmo_code
+	"ppm",       // parts_per_million
+}
+
+// unit returns the unit string of a variable, or "" if it is unknown.
+func (t *fbTable) unit() string {
+	if u := int(t.GetByteSlot(fbUnit, 0)); u < len(fbUnits) {
+		return fbUnits[u]
+	}
+	return ""
+}
+
+// fbAggregations are the suffixes of daily aggregations. The aggregation
+// itself is not checked.
+var fbAggregations = []string{"_max", "_min", "_mean", "_sum", "_dominant"}
+
+// fbLevelPattern matches the level suffix of a variable name, such as
+// "_2m", "_850hPa", "_0cm" or "_0_to_1cm".
+var fbLevelPattern = regexp.MustCompile(`_(\d+)(m|hPa|cm|_to_(\d+)cm)$`)
+
+// fbIdentity identifies a variable in a FlatBuffers message. The API sends
+// the base variable with its level in separate fields, e.g. "temperature_2m"
+// as temperature at an altitude of 2 m.
+type fbIdentity struct {
+	variable byte
+	level    flatbuffers.VOffsetT // field holding the level, or 0 if none
+	value    int16
+	depthTo  int16
+}
+
+// fbIdentityOf returns the identity of a variable name, or false if its
+// base variable is not in fbVariableCodes.
+func fbIdentityOf(name string) (fbIdentity, bool) {
+	base := name
+	for _, suffix := range fbAggregations {
+		if trimmed, ok := strings.CutSuffix(base, suffix); ok {
+			base = trimmed
+			break
+		}
+	}
+
+	var id fbIdentity
+	if m := fbLevelPattern.FindStringSubmatch(base); m != nil {
+		n, err := strconv.ParseInt(m[1], 10, 16)
+		if err != nil {
+			return fbIdentity{}, false
+		}
+		id.value = int16(n)
+		switch {
+		case m[2] == "m":
+			id.level = fbAltitude
+		case m[2] == "hPa":
+			id.level = fbPressureLevel
+		default:
+			id.level = fbDepth
+			if m[3] != "" {
+				to, err := strconv.ParseInt(m[3], 10, 16)
+				if err != nil {
+					return fbIdentity{}, false
+				}
+				id.depthTo = int16(to)
+			}
+		}
+		base = base[:len(base)-len(m[0])]
+	}
+
+	variable, ok := fbVariableCodes[base]
+	if !ok {
+		return fbIdentity{}, false
+	}
+	id.variable = variable
+	return id, true
+}
+
+// matches reports whether the VariableWithValues table t has the identity id.
+func (id fbIdentity) matches(t *fbTable) bool {
+	if t.GetByteSlot(fbVariable, 0) != id.variable {
+		return false
+	}
+	switch id.level {
+	case 0:
+		return true
+	case fbDepth:
+		return t.GetInt16Slot(fbDepth, 0) == id.value && (id.depthTo == 0 || t.GetInt16Slot(fbDepthTo, 0) == id.depthTo)
+	}
+	return t.GetInt16Slot(id.level, 0) == id.value
+}
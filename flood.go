@@ -0,0 +1,303 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// FloodMetric represents a daily metric that can be requested from the Flood API.
+type FloodMetric string
+
+// Daily river discharge metrics available from the Open-Meteo Flood API.
+// Statistics are computed over the GloFAS ensemble.
+const (
+	FloodRiverDischarge       FloodMetric = "river_discharge"
+	FloodRiverDischargeMean   FloodMetric = "river_discharge_mean"
+	FloodRiverDischargeMedian FloodMetric = "river_discharge_median"
+	FloodRiverDischargeMax    FloodMetric = "river_discharge_max"
+	FloodRiverDischargeMin    FloodMetric = "river_discharge_min"
+	FloodRiverDischargeP25    FloodMetric = "river_discharge_p25"
+	FloodRiverDischargeP75    FloodMetric = "river_discharge_p75"
+)
+
+// String returns the API parameter string for the metric.
+func (m FloodMetric) String() string {
+	return string(m)
+}
+
+// FloodModel selects the GloFAS dataset used by the Flood API.
+type FloodModel string
+
+const (
+	// FloodModelSeamless combines reanalysis and forecast (API default).
+	FloodModelSeamless FloodModel = "seamless_v4"
+	// FloodModelForecast uses the GloFAS forecast only.
+	FloodModelForecast FloodModel = "forecast_v4"
+	// FloodModelConsolidated uses the consolidated historical reanalysis only.
+	FloodModelConsolidated FloodModel = "consolidated_v4"
+)
+
+// FloodRequest represents a request to the Flood API.
+type FloodRequest struct {
+	location Location
+
+	// Metrics to request
+	dailyMetrics []FloodMetric
+
+	// Time options
+	forecastDays int
+	pastDays     int
+
+	// Date range options
+	startDate string
+	endDate   string
+
+	// Other options
+	ensemble      bool
+	models        []FloodModel
+	cellSelection CellSelection
+}
+
+// NewFloodRequest creates a new FloodRequest for the given coordinates.
+// The nearest river in the 5 km GloFAS grid is used.
+func NewFloodRequest(lat, lon float64) (*FloodRequest, error) {
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &FloodRequest{
+		location: loc,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *FloodRequest) WithLocation(loc Location) *FloodRequest {
+	r.location = loc
+	return r
+}
+
+// WithDaily adds daily metrics to the request.
+func (r *FloodRequest) WithDaily(metrics ...FloodMetric) *FloodRequest {
+	r.dailyMetrics = append(r.dailyMetrics, metrics...)
+	return r
+}
+
+// WithForecastDays sets the number of forecast days (0-210).
+func (r *FloodRequest) WithForecastDays(days int) *FloodRequest {
+	r.forecastDays = days
+	return r
+}
+
+// WithPastDays sets the number of past days to include.
+func (r *FloodRequest) WithPastDays(days int) *FloodRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *FloodRequest) WithDateRange(startDate, endDate string) *FloodRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithEnsemble requests all 50 ensemble members in addition to the control run.
+// Members are returned in FloodDailyData.RiverDischargeMembers.
+func (r *FloodRequest) WithEnsemble(enabled bool) *FloodRequest {
+	r.ensemble = enabled
+	return r
+}
+
+// WithModels sets the GloFAS dataset to use. The Flood API response has
+// no per-model layout, so Flood returns an error if more than one model is
+// set.
+func (r *FloodRequest) WithModels(models ...FloodModel) *FloodRequest {
+	r.models = append(r.models, models...)
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+func (r *FloodRequest) WithCellSelection(selection CellSelection) *FloodRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// validate checks that at most one model is requested.
+func (r *FloodRequest) validate() error {
+	if len(r.models) > 1 {
+		return fmt.Errorf("flood requests support one model, got %d", len(r.models))
+	}
+	return nil
+}
+
+// buildURL builds the URL for a flood request.
+func (r *FloodRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Metrics
+	if len(r.dailyMetrics) > 0 {
+		params.Set("daily", joinMetrics(r.dailyMetrics))
+	}
+
+	// Time options
+	if r.forecastDays > 0 {
+		params.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+
+	// Date range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+
+	// Other options
+	if r.ensemble {
+		params.Set("ensemble", "true")
+	}
+	if len(r.models) > 0 {
+		params.Set("models", joinMetrics(r.models))
+	}
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// Flood contains the response from the Flood API.
+type Flood struct {
+	// Location information (of the selected river grid cell)
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// Daily data
+	Daily      *FloodDailyData  `json:"-"` // parsed separately
+	DailyUnits *FloodDailyUnits `json:"daily_units,omitempty"`
+}
+
+// FloodDailyData contains daily river discharge data in m³/s.
+// Missing data points are stored as NaN; see Series.
+type FloodDailyData struct {
+	// Time contains timestamps for each day (at 00:00).
+	Times []time.Time `json:"-"` // parsed separately from "time" field
+
+	RiverDischarge       Series `json:"river_discharge,omitempty"`
+	RiverDischargeMean   Series `json:"river_discharge_mean,omitempty"`
+	RiverDischargeMedian Series `json:"river_discharge_median,omitempty"`
+	RiverDischargeMax    Series `json:"river_discharge_max,omitempty"`
+	RiverDischargeMin    Series `json:"river_discharge_min,omitempty"`
+	RiverDischargeP25    Series `json:"river_discharge_p25,omitempty"`
+	RiverDischargeP75    Series `json:"river_discharge_p75,omitempty"`
+
+	// RiverDischargeMembers contains the ensemble members when requested
+	// with WithEnsemble. Index 0 is the control run, index n is member n.
+	RiverDischargeMembers EnsembleMembers `json:"-"` // parsed separately
+}
+
+// FloodDailyUnits contains unit strings for daily flood metrics.
+type FloodDailyUnits struct {
+	RiverDischarge       string `json:"river_discharge,omitempty"`
+	RiverDischargeMean   string `json:"river_discharge_mean,omitempty"`
+	RiverDischargeMedian string `json:"river_discharge_median,omitempty"`
+	RiverDischargeMax    string `json:"river_discharge_max,omitempty"`
+	RiverDischargeMin    string `json:"river_discharge_min,omitempty"`
+	RiverDischargeP25    string `json:"river_discharge_p25,omitempty"`
+	RiverDischargeP75    string `json:"river_discharge_p75,omitempty"`
+}
+
+// rawFloodResponse represents the raw JSON response from the Flood API.
+type rawFloodResponse struct {
+	rawMeta
+
+	Daily      json.RawMessage  `json:"daily,omitempty"`
+	DailyUnits *FloodDailyUnits `json:"daily_units,omitempty"`
+}
+
+// parseFloodResponse parses the Flood API response into a Flood struct.
+func parseFloodResponse(body []byte) (*Flood, error) {
+	var raw rawFloodResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	flood := &Flood{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+		DailyUnits:           raw.DailyUnits,
+	}
+
+	if len(raw.Daily) == 0 {
+		return flood, nil
+	}
+
+	fields, err := unmarshalFields(raw.Daily)
+	if err != nil {
+		return nil, &DecodeError{Block: "daily", Err: err}
+	}
+	times, err := parseFieldTimes(fields, raw.timeLocation(), parseDateArray)
+	if err != nil {
+		return nil, &DecodeError{Block: "daily", Err: err}
+	}
+	groups, err := groupMembers(fields)
+	if err != nil {
+		return nil, &DecodeError{Block: "daily", Err: err}
+	}
+
+	// Index 0 of each group is the variable itself; ensemble members are
+	// only present when requested
+	daily := &FloodDailyData{Times: times}
+	for name, group := range groups {
+		floodDailyFields().setSeries(daily, name, group[0])
+	}
+	if members := groups[string(FloodRiverDischarge)]; len(members) > 1 {
+		daily.RiverDischargeMembers = members
+	}
+
+	flood.Daily = daily
+	return flood, nil
+}
+
+// Flood retrieves river discharge data for the given request.
+func (c *Client) Flood(ctx context.Context, req *FloodRequest) (*Flood, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	url := req.buildURL(c.floodURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFloodResponse(body)
+}
@@ -0,0 +1,100 @@
+package omgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloodRequestURL(t *testing.T) {
+	req, err := NewFloodRequest(59.91, 10.75)
+	require.NoError(t, err)
+
+	req.WithDaily(FloodRiverDischarge, FloodRiverDischargeP75, FloodRiverDischargeP25).
+		WithEnsemble(true).
+		WithModels(FloodModelForecast).
+		WithDateRange("2024-05-01", "2024-05-31")
+
+	parsed, err := url.Parse(req.buildURL(floodBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "59.91", params.Get("latitude"))
+	assert.Equal(t, "river_discharge,river_discharge_p25,river_discharge_p75", params.Get("daily"))
+	assert.Equal(t, "true", params.Get("ensemble"))
+	assert.Equal(t, "forecast_v4", params.Get("models"))
+	assert.Equal(t, "2024-05-01", params.Get("start_date"))
+	assert.Equal(t, "2024-05-31", params.Get("end_date"))
+
+	// Ensemble is omitted unless enabled
+	req.WithEnsemble(false)
+	parsed, err = url.Parse(req.buildURL(floodBaseURL, ""))
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Query().Get("ensemble"))
+}
+
+func TestParseFlood(t *testing.T) {
+	data, err := os.ReadFile("testdata/flood.json")
+	require.NoError(t, err)
+
+	flood, err := parseFloodResponse(data)
+	require.NoError(t, err)
+
+	require.NotNil(t, flood.Daily)
+	require.Len(t, flood.Daily.Times, 3)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), flood.Daily.Times[0].UTC())
+	assert.Equal(t, 120.5, flood.Daily.RiverDischarge[0])
+	assert.Equal(t, 171.5, flood.Daily.RiverDischargeMax[2])
+	assert.Equal(t, "m³/s", flood.DailyUnits.RiverDischarge)
+
+	// Control run plus two members
+	members := flood.Daily.RiverDischargeMembers
+	require.Len(t, members, 3)
+	assert.Equal(t, flood.Daily.RiverDischarge, members[0])
+	assert.Equal(t, 118.0, members[1][0])
+	assert.True(t, members[2].IsMissing(2))
+}
+
+func TestParseFloodWithoutMembers(t *testing.T) {
+	body := []byte(`{"daily":{"time":["2024-05-01"],"river_discharge":[1.5]}}`)
+
+	flood, err := parseFloodResponse(body)
+	require.NoError(t, err)
+	require.NotNil(t, flood.Daily)
+	assert.Nil(t, flood.Daily.RiverDischargeMembers)
+}
+
+func TestClientFloodAPIError(t *testing.T) {
+	mock := &mockHTTPClient{
+		response: newMockResponse(http.StatusBadRequest, []byte(`{"error":true,"reason":"Parameter 'daily' is required"}`)),
+	}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewFloodRequest(59.91, 10.75)
+	require.NoError(t, err)
+
+	_, err = client.Flood(context.Background(), req)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
+
+func TestClientFloodSeveralModels(t *testing.T) {
+	mock := &mockHTTPClient{err: errors.New("unexpected request")}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewFloodRequest(59.91, 10.75)
+	require.NoError(t, err)
+	req.WithDaily(FloodRiverDischarge).WithModels(FloodModelSeamless, FloodModelForecast)
+
+	_, err = client.Flood(context.Background(), req)
+	assert.ErrorContains(t, err, "flood requests support one model, got 2")
+}
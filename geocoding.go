@@ -0,0 +1,125 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GeocodingRequest represents a place-name search against the Geocoding API.
+type GeocodingRequest struct {
+	name        string
+	count       int
+	language    string
+	countryCode string
+}
+
+// NewGeocodingRequest creates a new GeocodingRequest for the given place name or postal code.
+func NewGeocodingRequest(name string) (*GeocodingRequest, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is required for geocoding requests")
+	}
+	return &GeocodingRequest{
+		name: name,
+	}, nil
+}
+
+// WithCount sets the maximum number of results to return (1-100, API default 10).
+func (r *GeocodingRequest) WithCount(count int) *GeocodingRequest {
+	r.count = count
+	return r
+}
+
+// WithLanguage sets the language for translated place names (e.g. "en", "de").
+func (r *GeocodingRequest) WithLanguage(lang string) *GeocodingRequest {
+	r.language = lang
+	return r
+}
+
+// WithCountryCode restricts results to a country, given as an ISO-3166-1 alpha2 code (e.g. "NL").
+func (r *GeocodingRequest) WithCountryCode(code string) *GeocodingRequest {
+	r.countryCode = code
+	return r
+}
+
+// buildURL builds the URL for a geocoding request.
+func (r *GeocodingRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+	params.Set("name", r.name)
+	if r.count > 0 {
+		params.Set("count", strconv.Itoa(r.count))
+	}
+	if r.language != "" {
+		params.Set("language", r.language)
+	}
+	if r.countryCode != "" {
+		params.Set("countryCode", r.countryCode)
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// GeocodingResult is a single place returned by the Geocoding API.
+type GeocodingResult struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Elevation   float64 `json:"elevation"`
+	Timezone    string  `json:"timezone"`
+	Population  int64   `json:"population,omitempty"`
+	FeatureCode string  `json:"feature_code"` // GeoNames feature code, e.g. "PPLC" for a capital
+
+	// Country
+	CountryCode string `json:"country_code"`
+	CountryID   int64  `json:"country_id,omitempty"`
+	Country     string `json:"country,omitempty"`
+
+	// Administrative areas, from largest (Admin1) to smallest (Admin4)
+	Admin1   string `json:"admin1,omitempty"`
+	Admin2   string `json:"admin2,omitempty"`
+	Admin3   string `json:"admin3,omitempty"`
+	Admin4   string `json:"admin4,omitempty"`
+	Admin1ID int64  `json:"admin1_id,omitempty"`
+	Admin2ID int64  `json:"admin2_id,omitempty"`
+	Admin3ID int64  `json:"admin3_id,omitempty"`
+	Admin4ID int64  `json:"admin4_id,omitempty"`
+
+	Postcodes []string `json:"postcodes,omitempty"`
+}
+
+// Location returns the result's coordinates as a Location,
+// using the result's elevation as elevation override.
+func (r GeocodingResult) Location() Location {
+	return Location{Latitude: r.Latitude, Longitude: r.Longitude}.WithElevation(r.Elevation)
+}
+
+// geocodingResponse represents the JSON response of the Geocoding API.
+type geocodingResponse struct {
+	Results          []GeocodingResult `json:"results"`
+	GenerationTimeMs float64           `json:"generationtime_ms"`
+}
+
+// Geocode searches for places matching the request.
+// An empty result (and no error) is returned when nothing matches.
+func (c *Client) Geocode(ctx context.Context, req *GeocodingRequest) ([]GeocodingResult, error) {
+	url := req.buildURL(c.geocodingURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, cacheForecast)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp geocodingResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+	return resp.Results, nil
+}
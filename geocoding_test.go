@@ -0,0 +1,76 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeocodingRequestURL(t *testing.T) {
+	req, err := NewGeocodingRequest("Amsterdam")
+	require.NoError(t, err)
+	req.WithCount(5).WithLanguage("nl").WithCountryCode("NL")
+
+	parsed, err := url.Parse(req.buildURL(geocodingBaseURL, "key"))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "Amsterdam", params.Get("name"))
+	assert.Equal(t, "5", params.Get("count"))
+	assert.Equal(t, "nl", params.Get("language"))
+	assert.Equal(t, "NL", params.Get("countryCode"))
+	assert.Equal(t, "key", params.Get("apikey"))
+
+	_, err = NewGeocodingRequest("")
+	assert.Error(t, err)
+}
+
+func TestClientGeocode(t *testing.T) {
+	data, err := os.ReadFile("testdata/geocoding.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{
+		response: newMockResponse(http.StatusOK, data),
+	}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewGeocodingRequest("Amsterdam")
+	require.NoError(t, err)
+
+	results, err := client.Geocode(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	r := results[0]
+	assert.Equal(t, "Amsterdam", r.Name)
+	assert.Equal(t, "Europe/Amsterdam", r.Timezone)
+	assert.Equal(t, "PPLC", r.FeatureCode)
+	assert.Equal(t, "North Holland", r.Admin1)
+	assert.Equal(t, int64(741636), r.Population)
+	assert.Equal(t, []string{"1011", "1012"}, r.Postcodes)
+
+	loc := r.Location()
+	assert.Equal(t, 52.37403, loc.Latitude)
+	assert.Equal(t, 4.88969, loc.Longitude)
+	require.NotNil(t, loc.Elevation)
+	assert.Equal(t, 13.0, *loc.Elevation)
+}
+
+func TestClientGeocodeNoResults(t *testing.T) {
+	mock := &mockHTTPClient{
+		response: newMockResponse(http.StatusOK, []byte(`{"generationtime_ms": 0.5}`)),
+	}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewGeocodingRequest("Nowhereville")
+	require.NoError(t, err)
+
+	results, err := client.Geocode(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
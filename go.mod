@@ -2,7 +2,10 @@ module github.com/hectormalot/omgo
 
 go 1.21
 
-require github.com/stretchr/testify v1.11.1
+require (
+	github.com/google/flatbuffers v25.2.10+incompatible
+	github.com/stretchr/testify v1.11.1
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -1,55 +1,68 @@
 package omgo
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // BaseMetrics contains fields shared between HourlyData and Minutely15Data.
 // These are embedded into both structs.
+// Missing data points are stored as NaN; see Series.
 type BaseMetrics struct {
 	// Time contains timestamps for each data point.
 	Times []time.Time `json:"-"` // parsed separately from "time" field
 
 	// Basic weather
-	Temperature2m       []float64 `json:"temperature_2m,omitempty"`
-	RelativeHumidity2m  []float64 `json:"relative_humidity_2m,omitempty"`
-	DewPoint2m          []float64 `json:"dew_point_2m,omitempty"`
-	ApparentTemperature []float64 `json:"apparent_temperature,omitempty"`
+	Temperature2m       Series `json:"temperature_2m,omitempty"`
+	RelativeHumidity2m  Series `json:"relative_humidity_2m,omitempty"`
+	DewPoint2m          Series `json:"dew_point_2m,omitempty"`
+	ApparentTemperature Series `json:"apparent_temperature,omitempty"`
 
 	// Precipitation
-	Precipitation []float64 `json:"precipitation,omitempty"`
-	Rain          []float64 `json:"rain,omitempty"`
-	Snowfall      []float64 `json:"snowfall,omitempty"`
+	Precipitation Series `json:"precipitation,omitempty"`
+	Rain          Series `json:"rain,omitempty"`
+	Snowfall      Series `json:"snowfall,omitempty"`
 
 	// Weather code
-	WeatherCode []WeatherCode `json:"weather_code,omitempty"`
+	WeatherCode WeatherCodes `json:"weather_code,omitempty"`
 
 	// Cloud cover
-	CloudCover     []float64 `json:"cloud_cover,omitempty"`
-	CloudCoverLow  []float64 `json:"cloud_cover_low,omitempty"`
-	CloudCoverMid  []float64 `json:"cloud_cover_mid,omitempty"`
-	CloudCoverHigh []float64 `json:"cloud_cover_high,omitempty"`
+	CloudCover     Series `json:"cloud_cover,omitempty"`
+	CloudCoverLow  Series `json:"cloud_cover_low,omitempty"`
+	CloudCoverMid  Series `json:"cloud_cover_mid,omitempty"`
+	CloudCoverHigh Series `json:"cloud_cover_high,omitempty"`
 
 	// Wind
-	WindSpeed10m     []float64 `json:"wind_speed_10m,omitempty"`
-	WindSpeed80m     []float64 `json:"wind_speed_80m,omitempty"`
-	WindDirection10m []float64 `json:"wind_direction_10m,omitempty"`
-	WindDirection80m []float64 `json:"wind_direction_80m,omitempty"`
-	WindGusts10m     []float64 `json:"wind_gusts_10m,omitempty"`
+	WindSpeed10m     Series `json:"wind_speed_10m,omitempty"`
+	WindSpeed80m     Series `json:"wind_speed_80m,omitempty"`
+	WindDirection10m Series `json:"wind_direction_10m,omitempty"`
+	WindDirection80m Series `json:"wind_direction_80m,omitempty"`
+	WindGusts10m     Series `json:"wind_gusts_10m,omitempty"`
 
 	// Solar radiation
-	ShortwaveRadiation     []float64 `json:"shortwave_radiation,omitempty"`
-	DirectRadiation        []float64 `json:"direct_radiation,omitempty"`
-	DirectNormalIrradiance []float64 `json:"direct_normal_irradiance,omitempty"`
-	DiffuseRadiation       []float64 `json:"diffuse_radiation,omitempty"`
-	GlobalTiltedIrradiance []float64 `json:"global_tilted_irradiance,omitempty"`
+	ShortwaveRadiation     Series `json:"shortwave_radiation,omitempty"`
+	DirectRadiation        Series `json:"direct_radiation,omitempty"`
+	DirectNormalIrradiance Series `json:"direct_normal_irradiance,omitempty"`
+	DiffuseRadiation       Series `json:"diffuse_radiation,omitempty"`
+	GlobalTiltedIrradiance Series `json:"global_tilted_irradiance,omitempty"`
+	TerrestrialRadiation   Series `json:"terrestrial_radiation,omitempty"`
+
+	// Instantaneous solar radiation (at the end of each interval)
+	ShortwaveRadiationInstant     Series `json:"shortwave_radiation_instant,omitempty"`
+	DirectRadiationInstant        Series `json:"direct_radiation_instant,omitempty"`
+	DirectNormalIrradianceInstant Series `json:"direct_normal_irradiance_instant,omitempty"`
+	DiffuseRadiationInstant       Series `json:"diffuse_radiation_instant,omitempty"`
+	GlobalTiltedIrradianceInstant Series `json:"global_tilted_irradiance_instant,omitempty"`
+	TerrestrialRadiationInstant   Series `json:"terrestrial_radiation_instant,omitempty"`
 
 	// Other
-	Visibility               []float64 `json:"visibility,omitempty"`
-	Evapotranspiration       []float64 `json:"evapotranspiration,omitempty"`
-	ET0FAOEvapotranspiration []float64 `json:"et0_fao_evapotranspiration,omitempty"`
-	VapourPressureDeficit    []float64 `json:"vapour_pressure_deficit,omitempty"`
-	Cape                     []float64 `json:"cape,omitempty"`
-	FreezingLevelHeight      []float64 `json:"freezing_level_height,omitempty"`
-	SunshineDuration         []float64 `json:"sunshine_duration,omitempty"`
+	Visibility               Series `json:"visibility,omitempty"`
+	Evapotranspiration       Series `json:"evapotranspiration,omitempty"`
+	ET0FAOEvapotranspiration Series `json:"et0_fao_evapotranspiration,omitempty"`
+	VapourPressureDeficit    Series `json:"vapour_pressure_deficit,omitempty"`
+	Cape                     Series `json:"cape,omitempty"`
+	FreezingLevelHeight      Series `json:"freezing_level_height,omitempty"`
+	SunshineDuration         Series `json:"sunshine_duration,omitempty"`
 }
 
 // HourlyData contains hourly weather data.
@@ -57,180 +70,222 @@ type HourlyData struct {
 	BaseMetrics // embedded shared fields
 
 	// Pressure
-	PressureMSL     []float64 `json:"pressure_msl,omitempty"`
-	SurfacePressure []float64 `json:"surface_pressure,omitempty"`
+	PressureMSL     Series `json:"pressure_msl,omitempty"`
+	SurfacePressure Series `json:"surface_pressure,omitempty"`
 
 	// Additional wind levels
-	WindSpeed120m     []float64 `json:"wind_speed_120m,omitempty"`
-	WindSpeed180m     []float64 `json:"wind_speed_180m,omitempty"`
-	WindDirection120m []float64 `json:"wind_direction_120m,omitempty"`
-	WindDirection180m []float64 `json:"wind_direction_180m,omitempty"`
+	WindSpeed120m     Series `json:"wind_speed_120m,omitempty"`
+	WindSpeed180m     Series `json:"wind_speed_180m,omitempty"`
+	WindDirection120m Series `json:"wind_direction_120m,omitempty"`
+	WindDirection180m Series `json:"wind_direction_180m,omitempty"`
 
 	// Snow and precipitation
-	SnowDepth                []float64 `json:"snow_depth,omitempty"`
-	PrecipitationProbability []float64 `json:"precipitation_probability,omitempty"`
-	Showers                  []float64 `json:"showers,omitempty"`
+	SnowDepth                Series `json:"snow_depth,omitempty"`
+	PrecipitationProbability Series `json:"precipitation_probability,omitempty"`
+	Showers                  Series `json:"showers,omitempty"`
 
 	// Is day (1 = day, 0 = night)
-	IsDay []int `json:"is_day,omitempty"`
+	IsDay Series `json:"is_day,omitempty"`
 
 	// Soil temperature
-	SoilTemperature0cm  []float64 `json:"soil_temperature_0cm,omitempty"`
-	SoilTemperature6cm  []float64 `json:"soil_temperature_6cm,omitempty"`
-	SoilTemperature18cm []float64 `json:"soil_temperature_18cm,omitempty"`
-	SoilTemperature54cm []float64 `json:"soil_temperature_54cm,omitempty"`
+	SoilTemperature0cm  Series `json:"soil_temperature_0cm,omitempty"`
+	SoilTemperature6cm  Series `json:"soil_temperature_6cm,omitempty"`
+	SoilTemperature18cm Series `json:"soil_temperature_18cm,omitempty"`
+	SoilTemperature54cm Series `json:"soil_temperature_54cm,omitempty"`
 
 	// Soil moisture
-	SoilMoisture0to1cm   []float64 `json:"soil_moisture_0_to_1cm,omitempty"`
-	SoilMoisture1to3cm   []float64 `json:"soil_moisture_1_to_3cm,omitempty"`
-	SoilMoisture3to9cm   []float64 `json:"soil_moisture_3_to_9cm,omitempty"`
-	SoilMoisture9to27cm  []float64 `json:"soil_moisture_9_to_27cm,omitempty"`
-	SoilMoisture27to81cm []float64 `json:"soil_moisture_27_to_81cm,omitempty"`
+	SoilMoisture0to1cm   Series `json:"soil_moisture_0_to_1cm,omitempty"`
+	SoilMoisture1to3cm   Series `json:"soil_moisture_1_to_3cm,omitempty"`
+	SoilMoisture3to9cm   Series `json:"soil_moisture_3_to_9cm,omitempty"`
+	SoilMoisture9to27cm  Series `json:"soil_moisture_9_to_27cm,omitempty"`
+	SoilMoisture27to81cm Series `json:"soil_moisture_27_to_81cm,omitempty"`
 
 	// Pressure level: Temperature
-	Temperature1000hPa []float64 `json:"temperature_1000hPa,omitempty"`
-	Temperature975hPa  []float64 `json:"temperature_975hPa,omitempty"`
-	Temperature950hPa  []float64 `json:"temperature_950hPa,omitempty"`
-	Temperature925hPa  []float64 `json:"temperature_925hPa,omitempty"`
-	Temperature900hPa  []float64 `json:"temperature_900hPa,omitempty"`
-	Temperature850hPa  []float64 `json:"temperature_850hPa,omitempty"`
-	Temperature800hPa  []float64 `json:"temperature_800hPa,omitempty"`
-	Temperature700hPa  []float64 `json:"temperature_700hPa,omitempty"`
-	Temperature600hPa  []float64 `json:"temperature_600hPa,omitempty"`
-	Temperature500hPa  []float64 `json:"temperature_500hPa,omitempty"`
-	Temperature400hPa  []float64 `json:"temperature_400hPa,omitempty"`
-	Temperature300hPa  []float64 `json:"temperature_300hPa,omitempty"`
-	Temperature250hPa  []float64 `json:"temperature_250hPa,omitempty"`
-	Temperature200hPa  []float64 `json:"temperature_200hPa,omitempty"`
-	Temperature150hPa  []float64 `json:"temperature_150hPa,omitempty"`
-	Temperature100hPa  []float64 `json:"temperature_100hPa,omitempty"`
-	Temperature70hPa   []float64 `json:"temperature_70hPa,omitempty"`
-	Temperature50hPa   []float64 `json:"temperature_50hPa,omitempty"`
-	Temperature30hPa   []float64 `json:"temperature_30hPa,omitempty"`
+	Temperature1000hPa Series `json:"temperature_1000hPa,omitempty"`
+	Temperature975hPa  Series `json:"temperature_975hPa,omitempty"`
+	Temperature950hPa  Series `json:"temperature_950hPa,omitempty"`
+	Temperature925hPa  Series `json:"temperature_925hPa,omitempty"`
+	Temperature900hPa  Series `json:"temperature_900hPa,omitempty"`
+	Temperature850hPa  Series `json:"temperature_850hPa,omitempty"`
+	Temperature800hPa  Series `json:"temperature_800hPa,omitempty"`
+	Temperature700hPa  Series `json:"temperature_700hPa,omitempty"`
+	Temperature600hPa  Series `json:"temperature_600hPa,omitempty"`
+	Temperature500hPa  Series `json:"temperature_500hPa,omitempty"`
+	Temperature400hPa  Series `json:"temperature_400hPa,omitempty"`
+	Temperature300hPa  Series `json:"temperature_300hPa,omitempty"`
+	Temperature250hPa  Series `json:"temperature_250hPa,omitempty"`
+	Temperature200hPa  Series `json:"temperature_200hPa,omitempty"`
+	Temperature150hPa  Series `json:"temperature_150hPa,omitempty"`
+	Temperature100hPa  Series `json:"temperature_100hPa,omitempty"`
+	Temperature70hPa   Series `json:"temperature_70hPa,omitempty"`
+	Temperature50hPa   Series `json:"temperature_50hPa,omitempty"`
+	Temperature30hPa   Series `json:"temperature_30hPa,omitempty"`
 
 	// Pressure level: Relative Humidity
-	RelativeHumidity1000hPa []float64 `json:"relative_humidity_1000hPa,omitempty"`
-	RelativeHumidity975hPa  []float64 `json:"relative_humidity_975hPa,omitempty"`
-	RelativeHumidity950hPa  []float64 `json:"relative_humidity_950hPa,omitempty"`
-	RelativeHumidity925hPa  []float64 `json:"relative_humidity_925hPa,omitempty"`
-	RelativeHumidity900hPa  []float64 `json:"relative_humidity_900hPa,omitempty"`
-	RelativeHumidity850hPa  []float64 `json:"relative_humidity_850hPa,omitempty"`
-	RelativeHumidity800hPa  []float64 `json:"relative_humidity_800hPa,omitempty"`
-	RelativeHumidity700hPa  []float64 `json:"relative_humidity_700hPa,omitempty"`
-	RelativeHumidity600hPa  []float64 `json:"relative_humidity_600hPa,omitempty"`
-	RelativeHumidity500hPa  []float64 `json:"relative_humidity_500hPa,omitempty"`
-	RelativeHumidity400hPa  []float64 `json:"relative_humidity_400hPa,omitempty"`
-	RelativeHumidity300hPa  []float64 `json:"relative_humidity_300hPa,omitempty"`
-	RelativeHumidity250hPa  []float64 `json:"relative_humidity_250hPa,omitempty"`
-	RelativeHumidity200hPa  []float64 `json:"relative_humidity_200hPa,omitempty"`
-	RelativeHumidity150hPa  []float64 `json:"relative_humidity_150hPa,omitempty"`
-	RelativeHumidity100hPa  []float64 `json:"relative_humidity_100hPa,omitempty"`
-	RelativeHumidity70hPa   []float64 `json:"relative_humidity_70hPa,omitempty"`
-	RelativeHumidity50hPa   []float64 `json:"relative_humidity_50hPa,omitempty"`
-	RelativeHumidity30hPa   []float64 `json:"relative_humidity_30hPa,omitempty"`
+	RelativeHumidity1000hPa Series `json:"relative_humidity_1000hPa,omitempty"`
+	RelativeHumidity975hPa  Series `json:"relative_humidity_975hPa,omitempty"`
+	RelativeHumidity950hPa  Series `json:"relative_humidity_950hPa,omitempty"`
+	RelativeHumidity925hPa  Series `json:"relative_humidity_925hPa,omitempty"`
+	RelativeHumidity900hPa  Series `json:"relative_humidity_900hPa,omitempty"`
+	RelativeHumidity850hPa  Series `json:"relative_humidity_850hPa,omitempty"`
+	RelativeHumidity800hPa  Series `json:"relative_humidity_800hPa,omitempty"`
+	RelativeHumidity700hPa  Series `json:"relative_humidity_700hPa,omitempty"`
+	RelativeHumidity600hPa  Series `json:"relative_humidity_600hPa,omitempty"`
+	RelativeHumidity500hPa  Series `json:"relative_humidity_500hPa,omitempty"`
+	RelativeHumidity400hPa  Series `json:"relative_humidity_400hPa,omitempty"`
+	RelativeHumidity300hPa  Series `json:"relative_humidity_300hPa,omitempty"`
+	RelativeHumidity250hPa  Series `json:"relative_humidity_250hPa,omitempty"`
+	RelativeHumidity200hPa  Series `json:"relative_humidity_200hPa,omitempty"`
+	RelativeHumidity150hPa  Series `json:"relative_humidity_150hPa,omitempty"`
+	RelativeHumidity100hPa  Series `json:"relative_humidity_100hPa,omitempty"`
+	RelativeHumidity70hPa   Series `json:"relative_humidity_70hPa,omitempty"`
+	RelativeHumidity50hPa   Series `json:"relative_humidity_50hPa,omitempty"`
+	RelativeHumidity30hPa   Series `json:"relative_humidity_30hPa,omitempty"`
 
 	// Pressure level: Dew Point
-	DewPoint1000hPa []float64 `json:"dew_point_1000hPa,omitempty"`
-	DewPoint975hPa  []float64 `json:"dew_point_975hPa,omitempty"`
-	DewPoint950hPa  []float64 `json:"dew_point_950hPa,omitempty"`
-	DewPoint925hPa  []float64 `json:"dew_point_925hPa,omitempty"`
-	DewPoint900hPa  []float64 `json:"dew_point_900hPa,omitempty"`
-	DewPoint850hPa  []float64 `json:"dew_point_850hPa,omitempty"`
-	DewPoint800hPa  []float64 `json:"dew_point_800hPa,omitempty"`
-	DewPoint700hPa  []float64 `json:"dew_point_700hPa,omitempty"`
-	DewPoint600hPa  []float64 `json:"dew_point_600hPa,omitempty"`
-	DewPoint500hPa  []float64 `json:"dew_point_500hPa,omitempty"`
-	DewPoint400hPa  []float64 `json:"dew_point_400hPa,omitempty"`
-	DewPoint300hPa  []float64 `json:"dew_point_300hPa,omitempty"`
-	DewPoint250hPa  []float64 `json:"dew_point_250hPa,omitempty"`
-	DewPoint200hPa  []float64 `json:"dew_point_200hPa,omitempty"`
-	DewPoint150hPa  []float64 `json:"dew_point_150hPa,omitempty"`
-	DewPoint100hPa  []float64 `json:"dew_point_100hPa,omitempty"`
-	DewPoint70hPa   []float64 `json:"dew_point_70hPa,omitempty"`
-	DewPoint50hPa   []float64 `json:"dew_point_50hPa,omitempty"`
-	DewPoint30hPa   []float64 `json:"dew_point_30hPa,omitempty"`
+	DewPoint1000hPa Series `json:"dew_point_1000hPa,omitempty"`
+	DewPoint975hPa  Series `json:"dew_point_975hPa,omitempty"`
+	DewPoint950hPa  Series `json:"dew_point_950hPa,omitempty"`
+	DewPoint925hPa  Series `json:"dew_point_925hPa,omitempty"`
+	DewPoint900hPa  Series `json:"dew_point_900hPa,omitempty"`
+	DewPoint850hPa  Series `json:"dew_point_850hPa,omitempty"`
+	DewPoint800hPa  Series `json:"dew_point_800hPa,omitempty"`
+	DewPoint700hPa  Series `json:"dew_point_700hPa,omitempty"`
+	DewPoint600hPa  Series `json:"dew_point_600hPa,omitempty"`
+	DewPoint500hPa  Series `json:"dew_point_500hPa,omitempty"`
+	DewPoint400hPa  Series `json:"dew_point_400hPa,omitempty"`
+	DewPoint300hPa  Series `json:"dew_point_300hPa,omitempty"`
+	DewPoint250hPa  Series `json:"dew_point_250hPa,omitempty"`
+	DewPoint200hPa  Series `json:"dew_point_200hPa,omitempty"`
+	DewPoint150hPa  Series `json:"dew_point_150hPa,omitempty"`
+	DewPoint100hPa  Series `json:"dew_point_100hPa,omitempty"`
+	DewPoint70hPa   Series `json:"dew_point_70hPa,omitempty"`
+	DewPoint50hPa   Series `json:"dew_point_50hPa,omitempty"`
+	DewPoint30hPa   Series `json:"dew_point_30hPa,omitempty"`
 
 	// Pressure level: Cloud Cover
-	CloudCover1000hPa []float64 `json:"cloud_cover_1000hPa,omitempty"`
-	CloudCover975hPa  []float64 `json:"cloud_cover_975hPa,omitempty"`
-	CloudCover950hPa  []float64 `json:"cloud_cover_950hPa,omitempty"`
-	CloudCover925hPa  []float64 `json:"cloud_cover_925hPa,omitempty"`
-	CloudCover900hPa  []float64 `json:"cloud_cover_900hPa,omitempty"`
-	CloudCover850hPa  []float64 `json:"cloud_cover_850hPa,omitempty"`
-	CloudCover800hPa  []float64 `json:"cloud_cover_800hPa,omitempty"`
-	CloudCover700hPa  []float64 `json:"cloud_cover_700hPa,omitempty"`
-	CloudCover600hPa  []float64 `json:"cloud_cover_600hPa,omitempty"`
-	CloudCover500hPa  []float64 `json:"cloud_cover_500hPa,omitempty"`
-	CloudCover400hPa  []float64 `json:"cloud_cover_400hPa,omitempty"`
-	CloudCover300hPa  []float64 `json:"cloud_cover_300hPa,omitempty"`
-	CloudCover250hPa  []float64 `json:"cloud_cover_250hPa,omitempty"`
-	CloudCover200hPa  []float64 `json:"cloud_cover_200hPa,omitempty"`
-	CloudCover150hPa  []float64 `json:"cloud_cover_150hPa,omitempty"`
-	CloudCover100hPa  []float64 `json:"cloud_cover_100hPa,omitempty"`
-	CloudCover70hPa   []float64 `json:"cloud_cover_70hPa,omitempty"`
-	CloudCover50hPa   []float64 `json:"cloud_cover_50hPa,omitempty"`
-	CloudCover30hPa   []float64 `json:"cloud_cover_30hPa,omitempty"`
+	CloudCover1000hPa Series `json:"cloud_cover_1000hPa,omitempty"`
+	CloudCover975hPa  Series `json:"cloud_cover_975hPa,omitempty"`
+	CloudCover950hPa  Series `json:"cloud_cover_950hPa,omitempty"`
+	CloudCover925hPa  Series `json:"cloud_cover_925hPa,omitempty"`
+	CloudCover900hPa  Series `json:"cloud_cover_900hPa,omitempty"`
+	CloudCover850hPa  Series `json:"cloud_cover_850hPa,omitempty"`
+	CloudCover800hPa  Series `json:"cloud_cover_800hPa,omitempty"`
+	CloudCover700hPa  Series `json:"cloud_cover_700hPa,omitempty"`
+	CloudCover600hPa  Series `json:"cloud_cover_600hPa,omitempty"`
+	CloudCover500hPa  Series `json:"cloud_cover_500hPa,omitempty"`
+	CloudCover400hPa  Series `json:"cloud_cover_400hPa,omitempty"`
+	CloudCover300hPa  Series `json:"cloud_cover_300hPa,omitempty"`
+	CloudCover250hPa  Series `json:"cloud_cover_250hPa,omitempty"`
+	CloudCover200hPa  Series `json:"cloud_cover_200hPa,omitempty"`
+	CloudCover150hPa  Series `json:"cloud_cover_150hPa,omitempty"`
+	CloudCover100hPa  Series `json:"cloud_cover_100hPa,omitempty"`
+	CloudCover70hPa   Series `json:"cloud_cover_70hPa,omitempty"`
+	CloudCover50hPa   Series `json:"cloud_cover_50hPa,omitempty"`
+	CloudCover30hPa   Series `json:"cloud_cover_30hPa,omitempty"`
 
 	// Pressure level: Wind Speed
-	WindSpeed1000hPa []float64 `json:"wind_speed_1000hPa,omitempty"`
-	WindSpeed975hPa  []float64 `json:"wind_speed_975hPa,omitempty"`
-	WindSpeed950hPa  []float64 `json:"wind_speed_950hPa,omitempty"`
-	WindSpeed925hPa  []float64 `json:"wind_speed_925hPa,omitempty"`
-	WindSpeed900hPa  []float64 `json:"wind_speed_900hPa,omitempty"`
-	WindSpeed850hPa  []float64 `json:"wind_speed_850hPa,omitempty"`
-	WindSpeed800hPa  []float64 `json:"wind_speed_800hPa,omitempty"`
-	WindSpeed700hPa  []float64 `json:"wind_speed_700hPa,omitempty"`
-	WindSpeed600hPa  []float64 `json:"wind_speed_600hPa,omitempty"`
-	WindSpeed500hPa  []float64 `json:"wind_speed_500hPa,omitempty"`
-	WindSpeed400hPa  []float64 `json:"wind_speed_400hPa,omitempty"`
-	WindSpeed300hPa  []float64 `json:"wind_speed_300hPa,omitempty"`
-	WindSpeed250hPa  []float64 `json:"wind_speed_250hPa,omitempty"`
-	WindSpeed200hPa  []float64 `json:"wind_speed_200hPa,omitempty"`
-	WindSpeed150hPa  []float64 `json:"wind_speed_150hPa,omitempty"`
-	WindSpeed100hPa  []float64 `json:"wind_speed_100hPa,omitempty"`
-	WindSpeed70hPa   []float64 `json:"wind_speed_70hPa,omitempty"`
-	WindSpeed50hPa   []float64 `json:"wind_speed_50hPa,omitempty"`
-	WindSpeed30hPa   []float64 `json:"wind_speed_30hPa,omitempty"`
+	WindSpeed1000hPa Series `json:"wind_speed_1000hPa,omitempty"`
+	WindSpeed975hPa  Series `json:"wind_speed_975hPa,omitempty"`
+	WindSpeed950hPa  Series `json:"wind_speed_950hPa,omitempty"`
+	WindSpeed925hPa  Series `json:"wind_speed_925hPa,omitempty"`
+	WindSpeed900hPa  Series `json:"wind_speed_900hPa,omitempty"`
+	WindSpeed850hPa  Series `json:"wind_speed_850hPa,omitempty"`
+	WindSpeed800hPa  Series `json:"wind_speed_800hPa,omitempty"`
+	WindSpeed700hPa  Series `json:"wind_speed_700hPa,omitempty"`
+	WindSpeed600hPa  Series `json:"wind_speed_600hPa,omitempty"`
+	WindSpeed500hPa  Series `json:"wind_speed_500hPa,omitempty"`
+	WindSpeed400hPa  Series `json:"wind_speed_400hPa,omitempty"`
+	WindSpeed300hPa  Series `json:"wind_speed_300hPa,omitempty"`
+	WindSpeed250hPa  Series `json:"wind_speed_250hPa,omitempty"`
+	WindSpeed200hPa  Series `json:"wind_speed_200hPa,omitempty"`
+	WindSpeed150hPa  Series `json:"wind_speed_150hPa,omitempty"`
+	WindSpeed100hPa  Series `json:"wind_speed_100hPa,omitempty"`
+	WindSpeed70hPa   Series `json:"wind_speed_70hPa,omitempty"`
+	WindSpeed50hPa   Series `json:"wind_speed_50hPa,omitempty"`
+	WindSpeed30hPa   Series `json:"wind_speed_30hPa,omitempty"`
 
 	// Pressure level: Wind Direction
-	WindDirection1000hPa []float64 `json:"wind_direction_1000hPa,omitempty"`
-	WindDirection975hPa  []float64 `json:"wind_direction_975hPa,omitempty"`
-	WindDirection950hPa  []float64 `json:"wind_direction_950hPa,omitempty"`
-	WindDirection925hPa  []float64 `json:"wind_direction_925hPa,omitempty"`
-	WindDirection900hPa  []float64 `json:"wind_direction_900hPa,omitempty"`
-	WindDirection850hPa  []float64 `json:"wind_direction_850hPa,omitempty"`
-	WindDirection800hPa  []float64 `json:"wind_direction_800hPa,omitempty"`
-	WindDirection700hPa  []float64 `json:"wind_direction_700hPa,omitempty"`
-	WindDirection600hPa  []float64 `json:"wind_direction_600hPa,omitempty"`
-	WindDirection500hPa  []float64 `json:"wind_direction_500hPa,omitempty"`
-	WindDirection400hPa  []float64 `json:"wind_direction_400hPa,omitempty"`
-	WindDirection300hPa  []float64 `json:"wind_direction_300hPa,omitempty"`
-	WindDirection250hPa  []float64 `json:"wind_direction_250hPa,omitempty"`
-	WindDirection200hPa  []float64 `json:"wind_direction_200hPa,omitempty"`
-	WindDirection150hPa  []float64 `json:"wind_direction_150hPa,omitempty"`
-	WindDirection100hPa  []float64 `json:"wind_direction_100hPa,omitempty"`
-	WindDirection70hPa   []float64 `json:"wind_direction_70hPa,omitempty"`
-	WindDirection50hPa   []float64 `json:"wind_direction_50hPa,omitempty"`
-	WindDirection30hPa   []float64 `json:"wind_direction_30hPa,omitempty"`
+	WindDirection1000hPa Series `json:"wind_direction_1000hPa,omitempty"`
+	WindDirection975hPa  Series `json:"wind_direction_975hPa,omitempty"`
+	WindDirection950hPa  Series `json:"wind_direction_950hPa,omitempty"`
+	WindDirection925hPa  Series `json:"wind_direction_925hPa,omitempty"`
+	WindDirection900hPa  Series `json:"wind_direction_900hPa,omitempty"`
+	WindDirection850hPa  Series `json:"wind_direction_850hPa,omitempty"`
+	WindDirection800hPa  Series `json:"wind_direction_800hPa,omitempty"`
+	WindDirection700hPa  Series `json:"wind_direction_700hPa,omitempty"`
+	WindDirection600hPa  Series `json:"wind_direction_600hPa,omitempty"`
+	WindDirection500hPa  Series `json:"wind_direction_500hPa,omitempty"`
+	WindDirection400hPa  Series `json:"wind_direction_400hPa,omitempty"`
+	WindDirection300hPa  Series `json:"wind_direction_300hPa,omitempty"`
+	WindDirection250hPa  Series `json:"wind_direction_250hPa,omitempty"`
+	WindDirection200hPa  Series `json:"wind_direction_200hPa,omitempty"`
+	WindDirection150hPa  Series `json:"wind_direction_150hPa,omitempty"`
+	WindDirection100hPa  Series `json:"wind_direction_100hPa,omitempty"`
+	WindDirection70hPa   Series `json:"wind_direction_70hPa,omitempty"`
+	WindDirection50hPa   Series `json:"wind_direction_50hPa,omitempty"`
+	WindDirection30hPa   Series `json:"wind_direction_30hPa,omitempty"`
 
 	// Pressure level: Geopotential Height
-	GeopotentialHeight1000hPa []float64 `json:"geopotential_height_1000hPa,omitempty"`
-	GeopotentialHeight975hPa  []float64 `json:"geopotential_height_975hPa,omitempty"`
-	GeopotentialHeight950hPa  []float64 `json:"geopotential_height_950hPa,omitempty"`
-	GeopotentialHeight925hPa  []float64 `json:"geopotential_height_925hPa,omitempty"`
-	GeopotentialHeight900hPa  []float64 `json:"geopotential_height_900hPa,omitempty"`
-	GeopotentialHeight850hPa  []float64 `json:"geopotential_height_850hPa,omitempty"`
-	GeopotentialHeight800hPa  []float64 `json:"geopotential_height_800hPa,omitempty"`
-	GeopotentialHeight700hPa  []float64 `json:"geopotential_height_700hPa,omitempty"`
-	GeopotentialHeight600hPa  []float64 `json:"geopotential_height_600hPa,omitempty"`
-	GeopotentialHeight500hPa  []float64 `json:"geopotential_height_500hPa,omitempty"`
-	GeopotentialHeight400hPa  []float64 `json:"geopotential_height_400hPa,omitempty"`
-	GeopotentialHeight300hPa  []float64 `json:"geopotential_height_300hPa,omitempty"`
-	GeopotentialHeight250hPa  []float64 `json:"geopotential_height_250hPa,omitempty"`
-	GeopotentialHeight200hPa  []float64 `json:"geopotential_height_200hPa,omitempty"`
-	GeopotentialHeight150hPa  []float64 `json:"geopotential_height_150hPa,omitempty"`
-	GeopotentialHeight100hPa  []float64 `json:"geopotential_height_100hPa,omitempty"`
-	GeopotentialHeight70hPa   []float64 `json:"geopotential_height_70hPa,omitempty"`
-	GeopotentialHeight50hPa   []float64 `json:"geopotential_height_50hPa,omitempty"`
-	GeopotentialHeight30hPa   []float64 `json:"geopotential_height_30hPa,omitempty"`
+	GeopotentialHeight1000hPa Series `json:"geopotential_height_1000hPa,omitempty"`
+	GeopotentialHeight975hPa  Series `json:"geopotential_height_975hPa,omitempty"`
+	GeopotentialHeight950hPa  Series `json:"geopotential_height_950hPa,omitempty"`
+	GeopotentialHeight925hPa  Series `json:"geopotential_height_925hPa,omitempty"`
+	GeopotentialHeight900hPa  Series `json:"geopotential_height_900hPa,omitempty"`
+	GeopotentialHeight850hPa  Series `json:"geopotential_height_850hPa,omitempty"`
+	GeopotentialHeight800hPa  Series `json:"geopotential_height_800hPa,omitempty"`
+	GeopotentialHeight700hPa  Series `json:"geopotential_height_700hPa,omitempty"`
+	GeopotentialHeight600hPa  Series `json:"geopotential_height_600hPa,omitempty"`
+	GeopotentialHeight500hPa  Series `json:"geopotential_height_500hPa,omitempty"`
+	GeopotentialHeight400hPa  Series `json:"geopotential_height_400hPa,omitempty"`
+	GeopotentialHeight300hPa  Series `json:"geopotential_height_300hPa,omitempty"`
+	GeopotentialHeight250hPa  Series `json:"geopotential_height_250hPa,omitempty"`
+	GeopotentialHeight200hPa  Series `json:"geopotential_height_200hPa,omitempty"`
+	GeopotentialHeight150hPa  Series `json:"geopotential_height_150hPa,omitempty"`
+	GeopotentialHeight100hPa  Series `json:"geopotential_height_100hPa,omitempty"`
+	GeopotentialHeight70hPa   Series `json:"geopotential_height_70hPa,omitempty"`
+	GeopotentialHeight50hPa   Series `json:"geopotential_height_50hPa,omitempty"`
+	GeopotentialHeight30hPa   Series `json:"geopotential_height_30hPa,omitempty"`
+
+	// Extra contains returned variables that have no field above, such as
+	// variables added to the API after this package was released.
+	Extra map[string]Series `json:"-"`
+}
+
+// UnmarshalJSON decodes hourly data, keeping unknown variables in Extra.
+func (h *HourlyData) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	return h.decodeFields(fields)
+}
+
+// decodeFields decodes the members of a hourly data block.
+func (h *HourlyData) decodeFields(fields map[string]json.RawMessage) (err error) {
+	h.Extra, err = decodeFields[Series](fields, h, hourlyFields())
+	return err
+}
+
+// Variables returns the metrics that were returned, in field order followed
+// by variables in Extra sorted by name.
+func (h *HourlyData) Variables() []HourlyMetric {
+	if h == nil {
+		return nil
+	}
+	return returned[HourlyMetric](hourlyFields().returnedSeries(h), h.Extra)
+}
+
+// Series returns the values of the given metric and whether it was returned.
+// This allows metrics to be selected by configuration rather than by field.
+// Variables without a field are looked up in Extra.
+func (h *HourlyData) Series(metric HourlyMetric) (Series, bool) {
+	if h == nil {
+		return nil, false
+	}
+	if s, ok := hourlyFields().series(h, string(metric)); ok {
+		return s, true
+	}
+	s, ok := h.Extra[string(metric)]
+	return s, ok
 }
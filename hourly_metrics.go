@@ -38,6 +38,15 @@ const (
 	HourlyDirectNormalIrradiance HourlyMetric = "direct_normal_irradiance"
 	HourlyDiffuseRadiation       HourlyMetric = "diffuse_radiation"
 	HourlyGlobalTiltedIrradiance HourlyMetric = "global_tilted_irradiance"
+	HourlyTerrestrialRadiation   HourlyMetric = "terrestrial_radiation"
+
+	// Solar radiation at the end of each interval rather than the preceding mean
+	HourlyShortwaveRadiationInstant     HourlyMetric = "shortwave_radiation_instant"
+	HourlyDirectRadiationInstant        HourlyMetric = "direct_radiation_instant"
+	HourlyDirectNormalIrradianceInstant HourlyMetric = "direct_normal_irradiance_instant"
+	HourlyDiffuseRadiationInstant       HourlyMetric = "diffuse_radiation_instant"
+	HourlyGlobalTiltedIrradianceInstant HourlyMetric = "global_tilted_irradiance_instant"
+	HourlyTerrestrialRadiationInstant   HourlyMetric = "terrestrial_radiation_instant"
 
 	// Precipitation
 	HourlyPrecipitation            HourlyMetric = "precipitation"
@@ -0,0 +1,134 @@
+package omgo
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Measurement names used by WriteLineProtocol.
+const (
+	MeasurementCurrent    = "current"
+	MeasurementHourly     = string(BlockHourly)
+	MeasurementMinutely15 = string(BlockMinutely15)
+	MeasurementDaily      = string(BlockDaily)
+)
+
+// lineEscaper escapes measurement names, tag keys and values, and field keys.
+var lineEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+
+// lineWriter writes points in InfluxDB line protocol.
+type lineWriter struct {
+	w    *bufio.Writer
+	tags string // escaped ",key=value" pairs shared by all points
+}
+
+// point writes a point with the given fields, or nothing if there are no fields.
+func (lw *lineWriter) point(measurement string, t time.Time, fields func(field func(key, value string))) {
+	n := 0
+	fields(func(key, value string) {
+		if n == 0 {
+			lw.w.WriteString(lineEscaper.Replace(measurement))
+			lw.w.WriteString(lw.tags)
+			lw.w.WriteByte(' ')
+		} else {
+			lw.w.WriteByte(',')
+		}
+		lw.w.WriteString(lineEscaper.Replace(key))
+		lw.w.WriteByte('=')
+		lw.w.WriteString(value)
+		n++
+	})
+	if n > 0 {
+		lw.w.WriteByte(' ')
+		lw.w.WriteString(strconv.FormatInt(t.UnixNano(), 10))
+		lw.w.WriteByte('\n')
+	}
+}
+
+// lineSeries writes a point per time step with a field per returned variable.
+// If extra is not nil, it is called to add further fields to each point.
+func lineSeries[M ~string](lw *lineWriter, measurement string, times []time.Time, metrics []M, series func(M) (Series, bool), extra func(i int, field func(key, value string))) {
+	values := make([]Series, len(metrics))
+	for j, m := range metrics {
+		values[j], _ = series(m)
+	}
+	for i, t := range times {
+		lw.point(measurement, t, func(field func(key, value string)) {
+			for j, m := range metrics {
+				if v, ok := values[j].At(i); ok {
+					field(string(m), formatLineFloat(v))
+				}
+			}
+			if extra != nil {
+				extra(i, field)
+			}
+		})
+	}
+}
+
+// write writes the blocks of d.
+func (lw *lineWriter) write(d *ModelData) {
+	if c := d.Current; c != nil {
+		lw.point(MeasurementCurrent, c.Time, func(field func(key, value string)) {
+			for _, m := range c.Variables() {
+				v, _ := c.Value(m)
+				field(string(m), formatLineFloat(v))
+			}
+		})
+	}
+	if h := d.Hourly; h != nil {
+		lineSeries(lw, MeasurementHourly, h.Times, h.Variables(), h.Series, nil)
+	}
+	if m := d.Minutely15; m != nil {
+		lineSeries(lw, MeasurementMinutely15, m.Times, m.Variables(), m.Series, nil)
+	}
+	if dd := d.Daily; dd != nil {
+		// Sunrise and sunset as Unix seconds
+		sun := func(i int, field func(key, value string)) {
+			if i < len(dd.Sunrise) && !dd.Sunrise[i].IsZero() {
+				field(string(DailySunrise), strconv.FormatInt(dd.Sunrise[i].Unix(), 10)+"i")
+			}
+			if i < len(dd.Sunset) && !dd.Sunset[i].IsZero() {
+				field(string(DailySunset), strconv.FormatInt(dd.Sunset[i].Unix(), 10)+"i")
+			}
+		}
+		lineSeries(lw, MeasurementDaily, dd.Times, dd.Variables(), dd.Series, sun)
+	}
+}
+
+// WriteLineProtocol writes the response as InfluxDB line protocol, with a
+// measurement per block ("current", "hourly", "minutely_15" and "daily"),
+// latitude and longitude tags, and a float field per returned variable.
+// Missing values are omitted; daily sunrise and sunset are integer Unix
+// seconds. Timestamps have nanosecond precision.
+//
+// If models were requested, each model is written with a "model" tag.
+func (w *Weather) WriteLineProtocol(out io.Writer) error {
+	bw := bufio.NewWriter(out)
+	tags := ",latitude=" + formatLineFloat(w.Latitude) + ",longitude=" + formatLineFloat(w.Longitude)
+
+	if len(w.Models) == 0 {
+		lw := &lineWriter{w: bw, tags: tags}
+		lw.write(w.data())
+		return bw.Flush()
+	}
+
+	models := make([]Model, 0, len(w.Models))
+	for m := range w.Models {
+		models = append(models, m)
+	}
+	slices.Sort(models)
+	for _, m := range models {
+		lw := &lineWriter{w: bw, tags: tags + ",model=" + lineEscaper.Replace(string(m))}
+		lw.write(w.Models[m])
+	}
+	return bw.Flush()
+}
+
+func formatLineFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
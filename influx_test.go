@@ -0,0 +1,72 @@
+package omgo
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteLineProtocol(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_extra.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, weather.WriteLineProtocol(&buf))
+
+	// Missing values are omitted, unknown variables follow known ones
+	expected := "current,latitude=52.52,longitude=13.419998 temperature_2m=3.5,convective_inhibition=12 1705327200000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998 temperature_2m=2.5,boundary_layer_height=450,convective_inhibition=10 1705276800000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998 temperature_2m=2.3,boundary_layer_height=520 1705280400000000000\n" +
+		"minutely_15,latitude=52.52,longitude=13.419998 precipitation=0,sunshine_minutes=15 1705276800000000000\n" +
+		"minutely_15,latitude=52.52,longitude=13.419998 precipitation=0.1,sunshine_minutes=0 1705277700000000000\n" +
+		"daily,latitude=52.52,longitude=13.419998 temperature_2m_max=5.2,cape_max=150,sunrise=1705306500i 1705276800000000000\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteLineProtocolNullCodes(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_null_codes.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, weather.WriteLineProtocol(&buf))
+
+	// Null weather codes are omitted rather than written as clear sky
+	expected := "hourly,latitude=52.52,longitude=13.419998 weather_code=3,is_day=0 1705276800000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998 weather_code=0,is_day=1 1705284000000000000\n" +
+		"daily,latitude=52.52,longitude=13.419998 weather_code=61 1705363200000000000\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteLineProtocolModels(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_models.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data, ModelICONSeamless, ModelECMWFIFS025)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, weather.WriteLineProtocol(&buf))
+
+	expected := "hourly,latitude=52.52,longitude=13.419998,model=ecmwf_ifs025 temperature_2m=2.1,precipitation=0 1705276800000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998,model=ecmwf_ifs025 temperature_2m=1.8,precipitation=0.1 1705280400000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998,model=ecmwf_ifs025 temperature_2m=1.5,precipitation=0 1705284000000000000\n" +
+		"daily,latitude=52.52,longitude=13.419998,model=ecmwf_ifs025 temperature_2m_max=4.2 1705276800000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998,model=icon_seamless temperature_2m=2.5,precipitation=0 1705276800000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998,model=icon_seamless temperature_2m=2.2,precipitation=0 1705280400000000000\n" +
+		"hourly,latitude=52.52,longitude=13.419998,model=icon_seamless precipitation=0.2 1705284000000000000\n" +
+		"daily,latitude=52.52,longitude=13.419998,model=icon_seamless temperature_2m_max=4.9 1705276800000000000\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestLineEscaper(t *testing.T) {
+	assert.Equal(t, `a\,b\=c\ d`, lineEscaper.Replace("a,b=c d"))
+}
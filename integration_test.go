@@ -89,10 +89,11 @@ func TestIntegrationForecastHourlyAndDaily(t *testing.T) {
 	assert.Equal(t, "°C", weather.DailyUnits.Temperature2mMax)
 
 	// Verify weather codes are valid
-	for _, code := range weather.Hourly.WeatherCode {
+	for i := range weather.Hourly.WeatherCode {
 		// Should produce a meaningful string, not "Unknown"
-		str := code.String()
-		assert.NotEmpty(t, str)
+		code, ok := weather.Hourly.WeatherCode.At(i)
+		require.True(t, ok)
+		assert.NotEmpty(t, code.String())
 	}
 }
 
@@ -215,3 +216,323 @@ func TestIntegrationHistoricalData(t *testing.T) {
 	t.Logf("Retrieved %d hours and %d days of historical data for Berlin",
 		len(weather.Hourly.Times), len(weather.Daily.Times))
 }
+
+func TestIntegrationGeocoding(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewGeocodingRequest("Amsterdam")
+	require.NoError(t, err)
+	req.WithCount(1).WithCountryCode("NL")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	results, err := client.Geocode(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	assert.Equal(t, "NL", results[0].CountryCode)
+	assert.InDelta(t, 52.37, results[0].Latitude, 0.1)
+	assert.Equal(t, "Europe/Amsterdam", results[0].Timezone)
+
+	// The result can be used directly for a forecast
+	freq, err := omgo.NewForecastRequest(0, 0)
+	require.NoError(t, err)
+	freq.WithLocation(results[0].Location()).WithHourly(omgo.HourlyTemperature2m)
+
+	weather, err := client.Forecast(ctx, freq)
+	require.NoError(t, err)
+	assert.InDelta(t, 52.37, weather.Latitude, 0.1)
+}
+
+func TestIntegrationElevation(t *testing.T) {
+	client := omgo.NewClient()
+
+	berlin, err := omgo.NewLocation(52.52, 13.41)
+	require.NoError(t, err)
+	zermatt, err := omgo.NewLocation(46.02, 7.75)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	locs := []omgo.Location{berlin, zermatt}
+	require.NoError(t, client.FillElevation(ctx, locs))
+
+	require.NotNil(t, locs[0].Elevation)
+	require.NotNil(t, locs[1].Elevation)
+	assert.Less(t, *locs[0].Elevation, 200.0)
+	assert.Greater(t, *locs[1].Elevation, 1000.0)
+}
+
+func TestIntegrationAirQuality(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewAirQualityRequest(52.52, 13.41) // Berlin
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.AirQualityPM10, omgo.AirQualityPM25, omgo.AirQualityEuropeanAQI).
+		WithCurrent(omgo.AirQualityUSAQI).
+		WithTimezone("Europe/Berlin").
+		WithForecastDays(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	aq, err := client.AirQuality(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, aq.Hourly)
+	assert.GreaterOrEqual(t, len(aq.Hourly.Times), 24)
+	assert.Equal(t, len(aq.Hourly.Times), len(aq.Hourly.PM10))
+	require.NotNil(t, aq.HourlyUnits)
+	assert.Equal(t, "μg/m³", aq.HourlyUnits.PM10)
+
+	require.NotNil(t, aq.Current)
+	require.NotNil(t, aq.Current.USAQI)
+}
+
+func TestIntegrationMarine(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewMarineRequest(54.54, 10.96) // Baltic Sea
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.MarineWaveHeight, omgo.MarineWavePeriod, omgo.MarineOceanCurrentVelocity).
+		WithDaily(omgo.MarineDailyWaveHeightMax).
+		WithForecastDays(2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	marine, err := client.Marine(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, marine.Hourly)
+	assert.GreaterOrEqual(t, len(marine.Hourly.Times), 48)
+	assert.Equal(t, len(marine.Hourly.Times), len(marine.Hourly.WaveHeight))
+
+	require.NotNil(t, marine.Daily)
+	assert.Len(t, marine.Daily.Times, 2)
+	require.NotNil(t, marine.HourlyUnits)
+	assert.Equal(t, "m", marine.HourlyUnits.WaveHeight)
+}
+
+func TestIntegrationFlood(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewFloodRequest(59.91, 10.75) // Oslo
+	require.NoError(t, err)
+
+	req.WithDaily(omgo.FloodRiverDischarge, omgo.FloodRiverDischargeMax).
+		WithForecastDays(7)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	flood, err := client.Flood(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, flood.Daily)
+	assert.Len(t, flood.Daily.Times, 7)
+	assert.Len(t, flood.Daily.RiverDischarge, 7)
+	require.NotNil(t, flood.DailyUnits)
+	assert.Equal(t, "m³/s", flood.DailyUnits.RiverDischarge)
+}
+
+func TestIntegrationEnsemble(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewEnsembleRequest(52.52, 13.41, omgo.EnsembleICONSeamless, omgo.EnsembleGFSSeamless) // Berlin
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.HourlyTemperature2m).
+		WithForecastDays(2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ensemble, err := client.Ensemble(ctx, req)
+	require.NoError(t, err)
+
+	require.Len(t, ensemble.Hourly, 2)
+	icon := ensemble.Hourly[omgo.EnsembleICONSeamless]
+	require.NotNil(t, icon)
+	assert.Len(t, icon.Times, 48)
+
+	members := icon.Members(omgo.HourlyTemperature2m)
+	assert.Greater(t, len(members), 1)
+	assert.Len(t, members.Mean(), 48)
+	assert.Equal(t, "°C", icon.Units[omgo.HourlyTemperature2m])
+}
+
+func TestIntegrationClimate(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewClimateRequest(52.52, 13.41, "2050-01-01", "2050-01-31", // Berlin
+		omgo.ClimateMRIAGCM32S, omgo.ClimateECEarth3PHR)
+	require.NoError(t, err)
+
+	req.WithDaily(omgo.ClimateTemperature2mMax, omgo.ClimatePrecipitationSum)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	climate, err := client.Climate(ctx, req)
+	require.NoError(t, err)
+
+	require.Len(t, climate.Daily, 2)
+	for _, model := range []omgo.ClimateModel{omgo.ClimateMRIAGCM32S, omgo.ClimateECEarth3PHR} {
+		daily := climate.Daily[model]
+		require.NotNil(t, daily, model)
+		assert.Len(t, daily.Times, 31)
+		assert.Len(t, daily.Temperature2mMax, 31)
+	}
+}
+
+func TestIntegrationSeasonal(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewSeasonalRequest(52.52, 13.41) // Berlin
+	require.NoError(t, err)
+
+	req.WithSixHourly(omgo.SeasonalTemperature2m).
+		WithDaily(omgo.SeasonalDailyPrecipitationSum).
+		WithForecastDays(45)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	seasonal, err := client.Seasonal(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, seasonal.SixHourly)
+	assert.Len(t, seasonal.SixHourly.Times, 45*4)
+	assert.Greater(t, len(seasonal.SixHourly.Members(omgo.SeasonalTemperature2m)), 1)
+
+	require.NotNil(t, seasonal.Daily)
+	assert.Len(t, seasonal.Daily.Times, 45)
+}
+
+func TestIntegrationForecastModels(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewForecastRequest(52.52, 13.41) // Berlin
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.HourlyTemperature2m).
+		WithDaily(omgo.DailyTemperature2mMax).
+		WithModels(omgo.ModelECMWFIFS025, omgo.ModelICONSeamless).
+		WithForecastDays(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	weather, err := client.Forecast(ctx, req)
+	require.NoError(t, err)
+
+	for _, m := range []omgo.Model{omgo.ModelECMWFIFS025, omgo.ModelICONSeamless} {
+		data := weather.ForModel(m)
+		require.NotNil(t, data, m)
+		require.NotNil(t, data.Hourly, m)
+		assert.Len(t, data.Hourly.Temperature2m, 24, m)
+		require.NotNil(t, data.Daily, m)
+		assert.Len(t, data.Daily.Temperature2mMax, 1, m)
+	}
+}
+
+func TestIntegrationForecastFlatBuffers(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewForecastRequest(52.52, 13.41) // Berlin
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.HourlyTemperature2m, omgo.HourlyWeatherCode).
+		WithDaily(omgo.DailyTemperature2mMax, omgo.DailySunrise).
+		WithCurrent(omgo.CurrentTemperature2m).
+		WithTimezone("Europe/Berlin").
+		WithForecastDays(2).
+		WithFormat(omgo.FormatFlatBuffers)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	weather, err := client.Forecast(ctx, req)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 52.52, weather.Latitude, 0.1)
+	assert.Equal(t, "Europe/Berlin", weather.Timezone)
+	require.NotNil(t, weather.Current)
+	assert.NotNil(t, weather.Current.Temperature2m)
+	require.NotNil(t, weather.Hourly)
+	assert.Len(t, weather.Hourly.Times, 48)
+	assert.Len(t, weather.Hourly.Temperature2m, 48)
+	assert.Len(t, weather.Hourly.WeatherCode, 48)
+	require.NotNil(t, weather.Daily)
+	assert.Len(t, weather.Daily.Temperature2mMax, 2)
+	assert.Len(t, weather.Daily.Sunrise, 2)
+}
+
+func TestIntegrationHistoricalForecast(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewHistoricalForecastRequest(52.52, 13.41, "2024-01-01", "2024-01-02") // Berlin
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.HourlyTemperature2m)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	weather, err := client.HistoricalForecast(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, weather.Hourly)
+	assert.Len(t, weather.Hourly.Times, 48)
+	assert.Len(t, weather.Hourly.Temperature2m, 48)
+}
+
+func TestIntegrationPreviousRuns(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewPreviousRunsRequest(52.52, 13.41) // Berlin
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.HourlyTemperature2m).
+		WithPreviousDays(1, 2).
+		WithPastDays(2).
+		WithForecastDays(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	runs, err := client.PreviousRuns(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, runs.Hourly)
+	temp := runs.Hourly.LeadTimes(omgo.HourlyTemperature2m)
+	require.Len(t, temp, 3)
+	assert.Len(t, temp.Day(2), len(runs.Hourly.Times))
+}
+
+func TestIntegrationSatelliteRadiation(t *testing.T) {
+	client := omgo.NewClient()
+
+	req, err := omgo.NewSatelliteRequest(47.37, 8.55) // Zurich
+	require.NoError(t, err)
+
+	req.WithHourly(omgo.SatelliteShortwaveRadiation, omgo.SatelliteDirectNormalIrradiance).
+		WithDateRange("2024-06-01", "2024-06-01")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	weather, err := client.SatelliteRadiation(ctx, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, weather.Hourly)
+	assert.Len(t, weather.Hourly.Times, 24)
+	assert.Len(t, weather.Hourly.ShortwaveRadiation, 24)
+	require.NotNil(t, weather.HourlyUnits)
+	assert.Equal(t, "W/m²", weather.HourlyUnits.ShortwaveRadiation)
+}
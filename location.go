@@ -13,13 +13,11 @@ type Location struct {
 // Latitude must be between -90 and 90.
 // Longitude must be between -180 and 180.
 func NewLocation(lat, lon float64) (Location, error) {
-	if lat < -90 || lat > 90 {
-		return Location{}, fmt.Errorf("latitude must be between -90 and 90, got %f", lat)
+	loc := Location{Latitude: lat, Longitude: lon}
+	if err := loc.validate(); err != nil {
+		return Location{}, err
 	}
-	if lon < -180 || lon > 180 {
-		return Location{}, fmt.Errorf("longitude must be between -180 and 180, got %f", lon)
-	}
-	return Location{Latitude: lat, Longitude: lon}, nil
+	return loc, nil
 }
 
 // WithElevation returns a copy of the Location with the specified elevation override.
@@ -28,3 +26,36 @@ func (l Location) WithElevation(meters float64) Location {
 	l.Elevation = &meters
 	return l
 }
+
+// validate checks that the coordinates are within range.
+func (l Location) validate() error {
+	if l.Latitude < -90 || l.Latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90, got %f", l.Latitude)
+	}
+	if l.Longitude < -180 || l.Longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180, got %f", l.Longitude)
+	}
+	return nil
+}
+
+// validateLocations checks a set of locations for a multi-location request.
+// The API accepts an elevation list only if it covers every coordinate, so
+// elevation overrides must be set on all locations or on none.
+func validateLocations(locs []Location) error {
+	if len(locs) == 0 {
+		return fmt.Errorf("at least one location is required")
+	}
+	withElevation := 0
+	for i, loc := range locs {
+		if err := loc.validate(); err != nil {
+			return fmt.Errorf("location %d: %w", i, err)
+		}
+		if loc.Elevation != nil {
+			withElevation++
+		}
+	}
+	if withElevation != 0 && withElevation != len(locs) {
+		return fmt.Errorf("elevation must be set on all locations or none, got %d of %d", withElevation, len(locs))
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package omgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// fieldIndex maps the JSON name of every field of a struct type, including
+// fields of embedded structs, to its field index. It allows data and unit
+// fields to be looked up by metric without a switch over every metric.
+type fieldIndex struct {
+	names  []string // in declaration order
+	fields map[string][]int
+}
+
+// newFieldIndex builds the field index of a struct type.
+func newFieldIndex(t reflect.Type) fieldIndex {
+	idx := fieldIndex{fields: make(map[string][]int)}
+	for _, f := range reflect.VisibleFields(t) {
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		idx.names = append(idx.names, name)
+		idx.fields[name] = f.Index
+	}
+	return idx
+}
+
+// Field indexes of the data and unit types, built on first use.
+var (
+	hourlyFields         = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(HourlyData{})) })
+	minutely15Fields     = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(Minutely15Data{})) })
+	dailyFields          = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(DailyData{})) })
+	currentFields        = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(CurrentData{})) })
+	hourlyUnitFields     = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(HourlyUnits{})) })
+	minutely15UnitFields = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(Minutely15Units{})) })
+	dailyUnitFields      = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(DailyUnits{})) })
+	currentUnitFields    = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(CurrentUnits{})) })
+	floodDailyFields     = sync.OnceValue(func() fieldIndex { return newFieldIndex(reflect.TypeOf(FloodDailyData{})) })
+)
+
+// series returns the named field of the struct pointed to by ptr as a Series.
+// It reports false if the field does not exist or was not returned.
+func (idx fieldIndex) series(ptr any, name string) (Series, bool) {
+	i, ok := idx.fields[name]
+	if !ok {
+		return nil, false
+	}
+	switch f := reflect.ValueOf(ptr).Elem().FieldByIndex(i).Interface().(type) {
+	case Series:
+		return f, f != nil
+	case WeatherCodes:
+		return Series(f), f != nil
+	}
+	return nil, false
+}
+
+// value returns the named field of the struct pointed to by ptr as a float64.
+// It reports false if the field does not exist or was not returned.
+func (idx fieldIndex) value(ptr any, name string) (float64, bool) {
+	i, ok := idx.fields[name]
+	if !ok {
+		return 0, false
+	}
+	switch f := reflect.ValueOf(ptr).Elem().FieldByIndex(i).Interface().(type) {
+	case *float64:
+		if f != nil {
+			return *f, true
+		}
+	case *int:
+		if f != nil {
+			return float64(*f), true
+		}
+	case *WeatherCode:
+		if f != nil {
+			return float64(*f), true
+		}
+	}
+	return 0, false
+}
+
+// setSeries stores s in the named field of the struct pointed to by ptr.
+// It reports false if the struct has no such field.
+func (idx fieldIndex) setSeries(ptr any, name string, s Series) bool {
+	i, ok := idx.fields[name]
+	if !ok {
+		return false
+	}
+	switch f := reflect.ValueOf(ptr).Elem().FieldByIndex(i).Addr().Interface().(type) {
+	case *Series:
+		*f = s
+	case *WeatherCodes:
+		*f = WeatherCodes(s)
+	default:
+		return false
+	}
+	return true
+}
+
+// setValue stores v in the named field of the struct pointed to by ptr,
+// converting it for weather code and other integer fields. It reports
+// false if the struct has no such field.
+func (idx fieldIndex) setValue(ptr any, name string, v float64) bool {
+	i, ok := idx.fields[name]
+	if !ok {
+		return false
+	}
+	switch f := reflect.ValueOf(ptr).Elem().FieldByIndex(i).Addr().Interface().(type) {
+	case **float64:
+		*f = &v
+	case **int:
+		n := int(v)
+		*f = &n
+	case **WeatherCode:
+		c := WeatherCode(v)
+		*f = &c
+	default:
+		return false
+	}
+	return true
+}
+
+// str returns the named string field of the struct pointed to by ptr,
+// or "" if the field does not exist.
+func (idx fieldIndex) str(ptr any, name string) string {
+	i, ok := idx.fields[name]
+	if !ok {
+		return ""
+	}
+	s, _ := reflect.ValueOf(ptr).Elem().FieldByIndex(i).Interface().(string)
+	return s
+}
+
+// setStr stores s in the named string field of the struct pointed to by
+// ptr. It reports false if the struct has no such field.
+func (idx fieldIndex) setStr(ptr any, name, s string) bool {
+	i, ok := idx.fields[name]
+	if !ok {
+		return false
+	}
+	f, ok := reflect.ValueOf(ptr).Elem().FieldByIndex(i).Addr().Interface().(*string)
+	if ok {
+		*f = s
+	}
+	return ok
+}
+
+// returnedSeries returns the names of the series fields of the struct pointed
+// to by ptr that were returned, in declaration order.
+func (idx fieldIndex) returnedSeries(ptr any) []string {
+	var names []string
+	for _, name := range idx.names {
+		if _, ok := idx.series(ptr, name); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// returnedValues returns the names of the value fields of the struct pointed
+// to by ptr that were returned, in declaration order.
+func (idx fieldIndex) returnedValues(ptr any) []string {
+	var names []string
+	for _, name := range idx.names {
+		if _, ok := idx.value(ptr, name); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// returned converts the names of returned fields to metrics and appends the
+// variables in extra, sorted by name.
+func returned[M ~string, V any](names []string, extra map[string]V) []M {
+	metrics := make([]M, 0, len(names)+len(extra))
+	for _, name := range names {
+		metrics = append(metrics, M(name))
+	}
+	unknown := make([]M, 0, len(extra))
+	for name := range extra {
+		unknown = append(unknown, M(name))
+	}
+	slices.Sort(unknown)
+	return append(metrics, unknown...)
+}
+
+// decodeFields decodes the members of a JSON object into the struct pointed
+// to by ptr, dispatching each member to its field in idx so the object is
+// decoded only once. Members without a field are decoded as V and returned,
+// so variables unknown to this package are kept. The "time" member, null
+// members and members that cannot be decoded as V, such as timestamps, are
+// skipped.
+func decodeFields[V any](fields map[string]json.RawMessage, ptr any, idx fieldIndex) (map[string]V, error) {
+	dst := reflect.ValueOf(ptr).Elem()
+	var extra map[string]V
+	for name, value := range fields {
+		if i, known := idx.fields[name]; known {
+			if err := json.Unmarshal(value, dst.FieldByIndex(i).Addr().Interface()); err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", name, err)
+			}
+			continue
+		}
+		if name == "time" || string(value) == "null" {
+			continue
+		}
+		var v V
+		if err := json.Unmarshal(value, &v); err != nil {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]V)
+		}
+		extra[name] = v
+	}
+	return extra, nil
+}
+
+// unmarshalFields decodes a JSON object into its raw members.
+func unmarshalFields(data []byte) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
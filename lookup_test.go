@@ -0,0 +1,117 @@
+package omgo
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHourlySeriesLookup(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	metrics := []HourlyMetric{HourlyTemperature2m, HourlyWindSpeed10m}
+	for _, m := range metrics {
+		s, ok := weather.Hourly.Series(m)
+		require.True(t, ok, m)
+		assert.Len(t, s, 3, m)
+	}
+
+	s, ok := weather.Hourly.Series(HourlyTemperature2m)
+	require.True(t, ok)
+	assert.Equal(t, weather.Hourly.Temperature2m, s)
+
+	// Weather codes are converted
+	s, ok = weather.Hourly.Series(HourlyWeatherCode)
+	require.True(t, ok)
+	assert.Equal(t, Series{3, 61, 3}, s)
+
+	// Not returned or unknown
+	_, ok = weather.Hourly.Series(HourlySnowDepth)
+	assert.False(t, ok)
+	_, ok = weather.Hourly.Series(HourlyMetric("unknown"))
+	assert.False(t, ok)
+	_, ok = (*HourlyData)(nil).Series(HourlyTemperature2m)
+	assert.False(t, ok)
+
+	assert.Equal(t, []HourlyMetric{
+		HourlyTemperature2m, HourlyRelativeHumidity2m, HourlyPrecipitation, HourlyWeatherCode, HourlyWindSpeed10m,
+	}, weather.Hourly.Variables())
+	assert.Nil(t, (*HourlyData)(nil).Variables())
+
+	// Units
+	assert.Equal(t, "°C", weather.HourlyUnits.For(HourlyTemperature2m))
+	assert.Equal(t, "km/h", weather.HourlyUnits.For(HourlyWindSpeed10m))
+	assert.Equal(t, "", weather.HourlyUnits.For(HourlySnowDepth))
+	assert.Equal(t, "", (*HourlyUnits)(nil).For(HourlyTemperature2m))
+}
+
+func TestDailySeriesLookup(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_daily.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	s, ok := weather.Daily.Series(DailyTemperature2mMax)
+	require.True(t, ok)
+	assert.Equal(t, Series{5.2, 6.1, 4.8}, s)
+
+	s, ok = weather.Daily.Series(DailyWeatherCode)
+	require.True(t, ok)
+	assert.Equal(t, Series{3, 61, 45}, s)
+
+	// Sun times are not numeric
+	_, ok = weather.Daily.Series(DailySunrise)
+	assert.False(t, ok)
+
+	assert.Equal(t, "mm", weather.DailyUnits.For(DailyPrecipitationSum))
+	assert.Equal(t, "", weather.DailyUnits.For(DailyUVIndexMax))
+}
+
+func TestCurrentValueLookup(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_current.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	v, ok := weather.Current.Value(CurrentTemperature2m)
+	require.True(t, ok)
+	assert.Equal(t, 3.5, v)
+
+	v, ok = weather.Current.Value(CurrentWeatherCode)
+	require.True(t, ok)
+	assert.Equal(t, 2.0, v)
+
+	v, ok = weather.Current.Value(CurrentIsDay)
+	require.True(t, ok)
+	assert.Equal(t, 1.0, v)
+
+	_, ok = weather.Current.Value(CurrentSnowfall)
+	assert.False(t, ok)
+
+	assert.ElementsMatch(t, []CurrentMetric{
+		CurrentTemperature2m, CurrentRelativeHumidity2m, CurrentApparentTemperature, CurrentIsDay, CurrentPrecipitation,
+		CurrentWeatherCode, CurrentCloudCover, CurrentWindSpeed10m, CurrentWindDirection10m,
+	}, weather.Current.Variables())
+
+	assert.Equal(t, "km/h", weather.CurrentUnits.For(CurrentWindSpeed10m))
+}
+
+func TestMinutely15SeriesLookup(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_nulls.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	s, ok := weather.Minutely15.Series(Minutely15Precipitation)
+	require.True(t, ok)
+	assert.True(t, s.IsMissing(0))
+}
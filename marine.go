@@ -0,0 +1,450 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MarineRequest represents a request to the Marine API.
+type MarineRequest struct {
+	location Location
+
+	// Metrics to request
+	hourlyMetrics  []MarineHourlyMetric
+	dailyMetrics   []MarineDailyMetric
+	currentMetrics []MarineCurrentMetric
+
+	// Units
+	lengthUnit LengthUnit
+
+	// Time options
+	timezone      string
+	forecastDays  int
+	pastDays      int
+	forecastHours int
+	pastHours     int
+
+	// Date range options
+	startDate string
+	endDate   string
+	startHour string
+	endHour   string
+
+	// Other options
+	cellSelection CellSelection
+}
+
+// NewMarineRequest creates a new MarineRequest for the given coordinates.
+func NewMarineRequest(lat, lon float64) (*MarineRequest, error) {
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &MarineRequest{
+		location: loc,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *MarineRequest) WithLocation(loc Location) *MarineRequest {
+	r.location = loc
+	return r
+}
+
+// WithHourly adds hourly metrics to the request.
+func (r *MarineRequest) WithHourly(metrics ...MarineHourlyMetric) *MarineRequest {
+	r.hourlyMetrics = append(r.hourlyMetrics, metrics...)
+	return r
+}
+
+// WithDaily adds daily metrics to the request.
+func (r *MarineRequest) WithDaily(metrics ...MarineDailyMetric) *MarineRequest {
+	r.dailyMetrics = append(r.dailyMetrics, metrics...)
+	return r
+}
+
+// WithCurrent adds current marine metrics to the request.
+func (r *MarineRequest) WithCurrent(metrics ...MarineCurrentMetric) *MarineRequest {
+	r.currentMetrics = append(r.currentMetrics, metrics...)
+	return r
+}
+
+// WithLengthUnit sets the unit for wave heights.
+func (r *MarineRequest) WithLengthUnit(unit LengthUnit) *MarineRequest {
+	r.lengthUnit = unit
+	return r
+}
+
+// WithTimezone sets the timezone for the response.
+// Use "auto" to automatically detect the timezone based on coordinates.
+func (r *MarineRequest) WithTimezone(tz string) *MarineRequest {
+	r.timezone = tz
+	return r
+}
+
+// WithForecastDays sets the number of forecast days (0-16).
+func (r *MarineRequest) WithForecastDays(days int) *MarineRequest {
+	r.forecastDays = days
+	return r
+}
+
+// WithPastDays sets the number of past days to include (0-92).
+func (r *MarineRequest) WithPastDays(days int) *MarineRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithForecastHours sets the number of forecast hours.
+func (r *MarineRequest) WithForecastHours(hours int) *MarineRequest {
+	r.forecastHours = hours
+	return r
+}
+
+// WithPastHours sets the number of past hours to include.
+func (r *MarineRequest) WithPastHours(hours int) *MarineRequest {
+	r.pastHours = hours
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *MarineRequest) WithDateRange(startDate, endDate string) *MarineRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithHourRange sets a specific hour range.
+// Times should be in ISO8601 format (yyyy-mm-ddThh:mm).
+func (r *MarineRequest) WithHourRange(startHour, endHour string) *MarineRequest {
+	r.startHour = startHour
+	r.endHour = endHour
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+// The Marine API defaults to CellSelectionSea.
+func (r *MarineRequest) WithCellSelection(selection CellSelection) *MarineRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// buildURL builds the URL for a marine request.
+func (r *MarineRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Metrics
+	if len(r.hourlyMetrics) > 0 {
+		params.Set("hourly", joinMetrics(r.hourlyMetrics))
+	}
+	if len(r.dailyMetrics) > 0 {
+		params.Set("daily", joinMetrics(r.dailyMetrics))
+	}
+	if len(r.currentMetrics) > 0 {
+		params.Set("current", joinMetrics(r.currentMetrics))
+	}
+
+	// Units
+	if r.lengthUnit != "" {
+		params.Set("length_unit", string(r.lengthUnit))
+	}
+
+	// Time options
+	if r.timezone != "" {
+		params.Set("timezone", r.timezone)
+	}
+	if r.forecastDays > 0 {
+		params.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+	if r.forecastHours > 0 {
+		params.Set("forecast_hours", strconv.Itoa(r.forecastHours))
+	}
+	if r.pastHours > 0 {
+		params.Set("past_hours", strconv.Itoa(r.pastHours))
+	}
+
+	// Date/time range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+	if r.startHour != "" {
+		params.Set("start_hour", r.startHour)
+	}
+	if r.endHour != "" {
+		params.Set("end_hour", r.endHour)
+	}
+
+	// Other options
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// Marine contains the response from the Marine API.
+type Marine struct {
+	// Location information
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// Current marine conditions
+	Current      *MarineCurrentData `json:"-"` // parsed separately
+	CurrentUnits *MarineUnits       `json:"current_units,omitempty"`
+
+	// Hourly data
+	Hourly      *MarineHourlyData `json:"-"` // parsed separately
+	HourlyUnits *MarineUnits      `json:"hourly_units,omitempty"`
+
+	// Daily data
+	Daily      *MarineDailyData  `json:"-"` // parsed separately
+	DailyUnits *MarineDailyUnits `json:"daily_units,omitempty"`
+}
+
+// MarineHourlyData contains hourly marine data.
+// Missing data points are stored as NaN; see Series.
+type MarineHourlyData struct {
+	// Time contains timestamps for each data point.
+	Times []time.Time `json:"-"` // parsed separately from "time" field
+
+	// Combined waves
+	WaveHeight     Series `json:"wave_height,omitempty"`
+	WaveDirection  Series `json:"wave_direction,omitempty"`
+	WavePeriod     Series `json:"wave_period,omitempty"`
+	WavePeakPeriod Series `json:"wave_peak_period,omitempty"`
+
+	// Wind waves
+	WindWaveHeight     Series `json:"wind_wave_height,omitempty"`
+	WindWaveDirection  Series `json:"wind_wave_direction,omitempty"`
+	WindWavePeriod     Series `json:"wind_wave_period,omitempty"`
+	WindWavePeakPeriod Series `json:"wind_wave_peak_period,omitempty"`
+
+	// Swell
+	SwellWaveHeight             Series `json:"swell_wave_height,omitempty"`
+	SwellWaveDirection          Series `json:"swell_wave_direction,omitempty"`
+	SwellWavePeriod             Series `json:"swell_wave_period,omitempty"`
+	SwellWavePeakPeriod         Series `json:"swell_wave_peak_period,omitempty"`
+	SecondarySwellWaveHeight    Series `json:"secondary_swell_wave_height,omitempty"`
+	SecondarySwellWaveDirection Series `json:"secondary_swell_wave_direction,omitempty"`
+	SecondarySwellWavePeriod    Series `json:"secondary_swell_wave_period,omitempty"`
+	TertiarySwellWaveHeight     Series `json:"tertiary_swell_wave_height,omitempty"`
+	TertiarySwellWaveDirection  Series `json:"tertiary_swell_wave_direction,omitempty"`
+	TertiarySwellWavePeriod     Series `json:"tertiary_swell_wave_period,omitempty"`
+
+	// Ocean
+	SeaSurfaceTemperature Series `json:"sea_surface_temperature,omitempty"`
+	SeaLevelHeightMSL     Series `json:"sea_level_height_msl,omitempty"`
+	InvertBarometerHeight Series `json:"invert_barometer_height,omitempty"`
+	OceanCurrentVelocity  Series `json:"ocean_current_velocity,omitempty"`
+	OceanCurrentDirection Series `json:"ocean_current_direction,omitempty"`
+}
+
+// MarineDailyData contains daily aggregated marine data.
+// Missing data points are stored as NaN; see Series.
+type MarineDailyData struct {
+	// Time contains timestamps for each day (at 00:00).
+	Times []time.Time `json:"-"` // parsed separately from "time" field
+
+	// Combined waves
+	WaveHeightMax         Series `json:"wave_height_max,omitempty"`
+	WaveDirectionDominant Series `json:"wave_direction_dominant,omitempty"`
+	WavePeriodMax         Series `json:"wave_period_max,omitempty"`
+
+	// Wind waves
+	WindWaveHeightMax         Series `json:"wind_wave_height_max,omitempty"`
+	WindWaveDirectionDominant Series `json:"wind_wave_direction_dominant,omitempty"`
+	WindWavePeriodMax         Series `json:"wind_wave_period_max,omitempty"`
+	WindWavePeakPeriodMax     Series `json:"wind_wave_peak_period_max,omitempty"`
+
+	// Swell
+	SwellWaveHeightMax         Series `json:"swell_wave_height_max,omitempty"`
+	SwellWaveDirectionDominant Series `json:"swell_wave_direction_dominant,omitempty"`
+	SwellWavePeriodMax         Series `json:"swell_wave_period_max,omitempty"`
+	SwellWavePeakPeriodMax     Series `json:"swell_wave_peak_period_max,omitempty"`
+}
+
+// MarineCurrentData contains current marine conditions.
+type MarineCurrentData struct {
+	// Time of the current observation.
+	Time time.Time `json:"-"` // parsed separately
+
+	// Interval is the time interval in seconds used for aggregations.
+	Interval int `json:"interval,omitempty"`
+
+	// Marine data
+	WaveHeight            *float64 `json:"wave_height,omitempty"`
+	WaveDirection         *float64 `json:"wave_direction,omitempty"`
+	WavePeriod            *float64 `json:"wave_period,omitempty"`
+	WindWaveHeight        *float64 `json:"wind_wave_height,omitempty"`
+	WindWaveDirection     *float64 `json:"wind_wave_direction,omitempty"`
+	WindWavePeriod        *float64 `json:"wind_wave_period,omitempty"`
+	SwellWaveHeight       *float64 `json:"swell_wave_height,omitempty"`
+	SwellWaveDirection    *float64 `json:"swell_wave_direction,omitempty"`
+	SwellWavePeriod       *float64 `json:"swell_wave_period,omitempty"`
+	SeaSurfaceTemperature *float64 `json:"sea_surface_temperature,omitempty"`
+	SeaLevelHeightMSL     *float64 `json:"sea_level_height_msl,omitempty"`
+	OceanCurrentVelocity  *float64 `json:"ocean_current_velocity,omitempty"`
+	OceanCurrentDirection *float64 `json:"ocean_current_direction,omitempty"`
+}
+
+// MarineUnits contains unit strings for hourly and current marine metrics.
+type MarineUnits struct {
+	WaveHeight                  string `json:"wave_height,omitempty"`
+	WaveDirection               string `json:"wave_direction,omitempty"`
+	WavePeriod                  string `json:"wave_period,omitempty"`
+	WavePeakPeriod              string `json:"wave_peak_period,omitempty"`
+	WindWaveHeight              string `json:"wind_wave_height,omitempty"`
+	WindWaveDirection           string `json:"wind_wave_direction,omitempty"`
+	WindWavePeriod              string `json:"wind_wave_period,omitempty"`
+	WindWavePeakPeriod          string `json:"wind_wave_peak_period,omitempty"`
+	SwellWaveHeight             string `json:"swell_wave_height,omitempty"`
+	SwellWaveDirection          string `json:"swell_wave_direction,omitempty"`
+	SwellWavePeriod             string `json:"swell_wave_period,omitempty"`
+	SwellWavePeakPeriod         string `json:"swell_wave_peak_period,omitempty"`
+	SecondarySwellWaveHeight    string `json:"secondary_swell_wave_height,omitempty"`
+	SecondarySwellWaveDirection string `json:"secondary_swell_wave_direction,omitempty"`
+	SecondarySwellWavePeriod    string `json:"secondary_swell_wave_period,omitempty"`
+	TertiarySwellWaveHeight     string `json:"tertiary_swell_wave_height,omitempty"`
+	TertiarySwellWaveDirection  string `json:"tertiary_swell_wave_direction,omitempty"`
+	TertiarySwellWavePeriod     string `json:"tertiary_swell_wave_period,omitempty"`
+	SeaSurfaceTemperature       string `json:"sea_surface_temperature,omitempty"`
+	SeaLevelHeightMSL           string `json:"sea_level_height_msl,omitempty"`
+	InvertBarometerHeight       string `json:"invert_barometer_height,omitempty"`
+	OceanCurrentVelocity        string `json:"ocean_current_velocity,omitempty"`
+	OceanCurrentDirection       string `json:"ocean_current_direction,omitempty"`
+}
+
+// MarineDailyUnits contains unit strings for daily marine metrics.
+type MarineDailyUnits struct {
+	WaveHeightMax              string `json:"wave_height_max,omitempty"`
+	WaveDirectionDominant      string `json:"wave_direction_dominant,omitempty"`
+	WavePeriodMax              string `json:"wave_period_max,omitempty"`
+	WindWaveHeightMax          string `json:"wind_wave_height_max,omitempty"`
+	WindWaveDirectionDominant  string `json:"wind_wave_direction_dominant,omitempty"`
+	WindWavePeriodMax          string `json:"wind_wave_period_max,omitempty"`
+	WindWavePeakPeriodMax      string `json:"wind_wave_peak_period_max,omitempty"`
+	SwellWaveHeightMax         string `json:"swell_wave_height_max,omitempty"`
+	SwellWaveDirectionDominant string `json:"swell_wave_direction_dominant,omitempty"`
+	SwellWavePeriodMax         string `json:"swell_wave_period_max,omitempty"`
+	SwellWavePeakPeriodMax     string `json:"swell_wave_peak_period_max,omitempty"`
+}
+
+// rawMarineResponse represents the raw JSON response from the Marine API.
+type rawMarineResponse struct {
+	rawMeta
+
+	Current      json.RawMessage `json:"current,omitempty"`
+	CurrentUnits *MarineUnits    `json:"current_units,omitempty"`
+
+	Hourly      json.RawMessage `json:"hourly,omitempty"`
+	HourlyUnits *MarineUnits    `json:"hourly_units,omitempty"`
+
+	Daily      json.RawMessage   `json:"daily,omitempty"`
+	DailyUnits *MarineDailyUnits `json:"daily_units,omitempty"`
+}
+
+// rawMarineCurrent represents the raw current marine data with time as string.
+type rawMarineCurrent struct {
+	Time string `json:"time"`
+	MarineCurrentData
+}
+
+// parseMarineResponse parses the Marine API response into a Marine struct.
+func parseMarineResponse(body []byte) (*Marine, error) {
+	var raw rawMarineResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	// Load timezone for proper time parsing
+	loc := raw.timeLocation()
+
+	marine := &Marine{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Elevation:            raw.Elevation,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+		CurrentUnits:         raw.CurrentUnits,
+		HourlyUnits:          raw.HourlyUnits,
+		DailyUnits:           raw.DailyUnits,
+	}
+
+	// Parse current conditions
+	if len(raw.Current) > 0 {
+		var current rawMarineCurrent
+		if err := json.Unmarshal(raw.Current, &current); err != nil {
+			return nil, &DecodeError{Block: "current", Err: err}
+		}
+		t, err := parseDateTime(current.Time, loc)
+		if err != nil {
+			return nil, &DecodeError{Block: "current", Err: err}
+		}
+		current.MarineCurrentData.Time = t
+		marine.Current = &current.MarineCurrentData
+	}
+
+	// Parse hourly data
+	if len(raw.Hourly) > 0 {
+		hourly := &MarineHourlyData{}
+		times, err := parseTimeBlock(raw.Hourly, loc, hourly)
+		if err != nil {
+			return nil, &DecodeError{Block: "hourly", Err: err}
+		}
+		hourly.Times = times
+		marine.Hourly = hourly
+	}
+
+	// Parse daily data
+	if len(raw.Daily) > 0 {
+		daily := &MarineDailyData{}
+		times, err := parseDateBlock(raw.Daily, loc, daily)
+		if err != nil {
+			return nil, &DecodeError{Block: "daily", Err: err}
+		}
+		daily.Times = times
+		marine.Daily = daily
+	}
+
+	return marine, nil
+}
+
+// Marine retrieves marine weather data for the given request.
+func (c *Client) Marine(ctx context.Context, req *MarineRequest) (*Marine, error) {
+	url := req.buildURL(c.marineURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMarineResponse(body)
+}
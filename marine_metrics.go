@@ -0,0 +1,96 @@
+package omgo
+
+// MarineHourlyMetric represents a metric that can be requested for hourly marine data.
+type MarineHourlyMetric string
+
+// Hourly marine metrics available from the Open-Meteo Marine API.
+const (
+	// Combined waves
+	MarineWaveHeight     MarineHourlyMetric = "wave_height"
+	MarineWaveDirection  MarineHourlyMetric = "wave_direction"
+	MarineWavePeriod     MarineHourlyMetric = "wave_period"
+	MarineWavePeakPeriod MarineHourlyMetric = "wave_peak_period"
+
+	// Wind waves
+	MarineWindWaveHeight     MarineHourlyMetric = "wind_wave_height"
+	MarineWindWaveDirection  MarineHourlyMetric = "wind_wave_direction"
+	MarineWindWavePeriod     MarineHourlyMetric = "wind_wave_period"
+	MarineWindWavePeakPeriod MarineHourlyMetric = "wind_wave_peak_period"
+
+	// Swell
+	MarineSwellWaveHeight             MarineHourlyMetric = "swell_wave_height"
+	MarineSwellWaveDirection          MarineHourlyMetric = "swell_wave_direction"
+	MarineSwellWavePeriod             MarineHourlyMetric = "swell_wave_period"
+	MarineSwellWavePeakPeriod         MarineHourlyMetric = "swell_wave_peak_period"
+	MarineSecondarySwellWaveHeight    MarineHourlyMetric = "secondary_swell_wave_height"
+	MarineSecondarySwellWaveDirection MarineHourlyMetric = "secondary_swell_wave_direction"
+	MarineSecondarySwellWavePeriod    MarineHourlyMetric = "secondary_swell_wave_period"
+	MarineTertiarySwellWaveHeight     MarineHourlyMetric = "tertiary_swell_wave_height"
+	MarineTertiarySwellWaveDirection  MarineHourlyMetric = "tertiary_swell_wave_direction"
+	MarineTertiarySwellWavePeriod     MarineHourlyMetric = "tertiary_swell_wave_period"
+
+	// Ocean
+	MarineSeaSurfaceTemperature MarineHourlyMetric = "sea_surface_temperature"
+	MarineSeaLevelHeightMSL     MarineHourlyMetric = "sea_level_height_msl"
+	MarineInvertBarometerHeight MarineHourlyMetric = "invert_barometer_height"
+	MarineOceanCurrentVelocity  MarineHourlyMetric = "ocean_current_velocity"
+	MarineOceanCurrentDirection MarineHourlyMetric = "ocean_current_direction"
+)
+
+// String returns the API parameter string for the metric.
+func (m MarineHourlyMetric) String() string {
+	return string(m)
+}
+
+// MarineDailyMetric represents a metric that can be requested for daily marine data.
+type MarineDailyMetric string
+
+// Daily marine metrics available from the Open-Meteo Marine API.
+const (
+	// Combined waves
+	MarineDailyWaveHeightMax         MarineDailyMetric = "wave_height_max"
+	MarineDailyWaveDirectionDominant MarineDailyMetric = "wave_direction_dominant"
+	MarineDailyWavePeriodMax         MarineDailyMetric = "wave_period_max"
+
+	// Wind waves
+	MarineDailyWindWaveHeightMax         MarineDailyMetric = "wind_wave_height_max"
+	MarineDailyWindWaveDirectionDominant MarineDailyMetric = "wind_wave_direction_dominant"
+	MarineDailyWindWavePeriodMax         MarineDailyMetric = "wind_wave_period_max"
+	MarineDailyWindWavePeakPeriodMax     MarineDailyMetric = "wind_wave_peak_period_max"
+
+	// Swell
+	MarineDailySwellWaveHeightMax         MarineDailyMetric = "swell_wave_height_max"
+	MarineDailySwellWaveDirectionDominant MarineDailyMetric = "swell_wave_direction_dominant"
+	MarineDailySwellWavePeriodMax         MarineDailyMetric = "swell_wave_period_max"
+	MarineDailySwellWavePeakPeriodMax     MarineDailyMetric = "swell_wave_peak_period_max"
+)
+
+// String returns the API parameter string for the metric.
+func (m MarineDailyMetric) String() string {
+	return string(m)
+}
+
+// MarineCurrentMetric represents a metric that can be requested for current marine conditions.
+type MarineCurrentMetric string
+
+// Current marine metrics available from the Open-Meteo Marine API.
+const (
+	MarineCurrentWaveHeight            MarineCurrentMetric = "wave_height"
+	MarineCurrentWaveDirection         MarineCurrentMetric = "wave_direction"
+	MarineCurrentWavePeriod            MarineCurrentMetric = "wave_period"
+	MarineCurrentWindWaveHeight        MarineCurrentMetric = "wind_wave_height"
+	MarineCurrentWindWaveDirection     MarineCurrentMetric = "wind_wave_direction"
+	MarineCurrentWindWavePeriod        MarineCurrentMetric = "wind_wave_period"
+	MarineCurrentSwellWaveHeight       MarineCurrentMetric = "swell_wave_height"
+	MarineCurrentSwellWaveDirection    MarineCurrentMetric = "swell_wave_direction"
+	MarineCurrentSwellWavePeriod       MarineCurrentMetric = "swell_wave_period"
+	MarineCurrentSeaSurfaceTemperature MarineCurrentMetric = "sea_surface_temperature"
+	MarineCurrentSeaLevelHeightMSL     MarineCurrentMetric = "sea_level_height_msl"
+	MarineCurrentOceanCurrentVelocity  MarineCurrentMetric = "ocean_current_velocity"
+	MarineCurrentOceanCurrentDirection MarineCurrentMetric = "ocean_current_direction"
+)
+
+// String returns the API parameter string for the metric.
+func (m MarineCurrentMetric) String() string {
+	return string(m)
+}
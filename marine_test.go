@@ -0,0 +1,87 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarineRequestURL(t *testing.T) {
+	req, err := NewMarineRequest(54.54, 10.96)
+	require.NoError(t, err)
+
+	req.WithHourly(MarineWaveHeight, MarineSwellWaveDirection).
+		WithDaily(MarineDailyWaveHeightMax).
+		WithCurrent(MarineCurrentOceanCurrentVelocity).
+		WithLengthUnit(Imperial).
+		WithTimezone("auto").
+		WithPastDays(1).
+		WithCellSelection(CellSelectionSea)
+
+	parsed, err := url.Parse(req.buildURL(marineBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "54.54", params.Get("latitude"))
+	assert.Equal(t, "10.96", params.Get("longitude"))
+	assert.Equal(t, "swell_wave_direction,wave_height", params.Get("hourly"))
+	assert.Equal(t, "wave_height_max", params.Get("daily"))
+	assert.Equal(t, "ocean_current_velocity", params.Get("current"))
+	assert.Equal(t, "imperial", params.Get("length_unit"))
+	assert.Equal(t, "auto", params.Get("timezone"))
+	assert.Equal(t, "1", params.Get("past_days"))
+	assert.Equal(t, "sea", params.Get("cell_selection"))
+}
+
+func TestParseMarine(t *testing.T) {
+	data, err := os.ReadFile("testdata/marine.json")
+	require.NoError(t, err)
+
+	marine, err := parseMarineResponse(data)
+	require.NoError(t, err)
+
+	require.NotNil(t, marine.Current)
+	assert.Equal(t, time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), marine.Current.Time.UTC())
+	require.NotNil(t, marine.Current.WaveHeight)
+	assert.Equal(t, 0.84, *marine.Current.WaveHeight)
+	assert.Equal(t, "km/h", marine.CurrentUnits.OceanCurrentVelocity)
+
+	require.NotNil(t, marine.Hourly)
+	assert.Len(t, marine.Hourly.Times, 3)
+	assert.Equal(t, 0.9, marine.Hourly.WaveHeight[0])
+	assert.Equal(t, 3.6, marine.Hourly.WavePeriod[0])
+	assert.True(t, marine.Hourly.SwellWaveDirection.IsMissing(2))
+	assert.Equal(t, "s", marine.HourlyUnits.WavePeriod)
+
+	require.NotNil(t, marine.Daily)
+	assert.Equal(t, time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), marine.Daily.Times[1].UTC())
+	assert.Equal(t, 1.3, marine.Daily.WaveHeightMax[1])
+	assert.Equal(t, "m", marine.DailyUnits.WaveHeightMax)
+}
+
+func TestClientMarine(t *testing.T) {
+	data, err := os.ReadFile("testdata/marine.json")
+	require.NoError(t, err)
+
+	var requested string
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.String()
+		return newMockResponse(http.StatusOK, data), nil
+	})
+	client := NewClient(WithHTTPClient(mock), WithMarineURL("http://localhost:8080/v1/marine"))
+
+	req, err := NewMarineRequest(54.54, 10.96)
+	require.NoError(t, err)
+	req.WithHourly(MarineWaveHeight)
+
+	marine, err := client.Marine(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, marine.Hourly)
+	assert.Contains(t, requested, "http://localhost:8080/v1/marine?")
+}
@@ -0,0 +1,103 @@
+package omgo
+
+import (
+	"math"
+	"sort"
+)
+
+// EnsembleMembers holds one Series per ensemble member of a variable.
+// Index 0 is the control run and index n is member n. Members that were not
+// returned by the API are nil.
+//
+// The statistics below are computed per time step across all members,
+// ignoring missing values. A time step without any value yields NaN.
+type EnsembleMembers []Series
+
+// Len returns the number of time steps, i.e. the length of the longest member.
+func (m EnsembleMembers) Len() int {
+	n := 0
+	for _, s := range m {
+		n = max(n, len(s))
+	}
+	return n
+}
+
+// Mean returns the ensemble mean for each time step.
+func (m EnsembleMembers) Mean() Series {
+	return m.reduce(func(values []float64) float64 {
+		return mean(values)
+	})
+}
+
+// Spread returns the ensemble spread (standard deviation across members)
+// for each time step.
+func (m EnsembleMembers) Spread() Series {
+	return m.reduce(func(values []float64) float64 {
+		avg := mean(values)
+		var sum float64
+		for _, v := range values {
+			sum += (v - avg) * (v - avg)
+		}
+		return math.Sqrt(sum / float64(len(values)))
+	})
+}
+
+// Percentile returns the p-th percentile (0-100) across members for each
+// time step, using linear interpolation between the closest ranks.
+// Values of p outside 0-100 are clamped.
+func (m EnsembleMembers) Percentile(p float64) Series {
+	p = math.Max(0, math.Min(100, p))
+	return m.reduce(func(values []float64) float64 {
+		sort.Float64s(values)
+		rank := p / 100 * float64(len(values)-1)
+		lower := int(math.Floor(rank))
+		upper := int(math.Ceil(rank))
+		frac := rank - float64(lower)
+		return values[lower] + frac*(values[upper]-values[lower])
+	})
+}
+
+// ExceedanceProbability returns, for each time step, the fraction (0-1) of
+// members with a value strictly greater than threshold.
+func (m EnsembleMembers) ExceedanceProbability(threshold float64) Series {
+	return m.reduce(func(values []float64) float64 {
+		n := 0
+		for _, v := range values {
+			if v > threshold {
+				n++
+			}
+		}
+		return float64(n) / float64(len(values))
+	})
+}
+
+// reduce applies fn to the available member values of each time step.
+// The slice passed to fn is reused between calls and may be reordered.
+func (m EnsembleMembers) reduce(fn func(values []float64) float64) Series {
+	n := m.Len()
+	out := make(Series, n)
+	values := make([]float64, 0, len(m))
+	for t := 0; t < n; t++ {
+		values = values[:0]
+		for _, s := range m {
+			if v, ok := s.At(t); ok {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			out[t] = math.NaN()
+			continue
+		}
+		out[t] = fn(values)
+	}
+	return out
+}
+
+// mean returns the arithmetic mean of a non-empty slice.
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
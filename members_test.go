@@ -0,0 +1,33 @@
+package omgo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsembleMembersStatistics(t *testing.T) {
+	members := EnsembleMembers{
+		{1, 2, math.NaN()},
+		{3, 4, math.NaN()},
+		{5, 9},
+		nil,
+	}
+
+	assert.Equal(t, 3, members.Len())
+
+	mean := members.Mean()
+	assert.Equal(t, Series{3, 5}, mean[:2])
+	assert.True(t, mean.IsMissing(2))
+
+	spread := members.Spread()
+	assert.InDelta(t, math.Sqrt(8.0/3), spread[0], 1e-9)
+	assert.True(t, spread.IsMissing(2))
+
+	assert.Equal(t, Series{3, 4}, members.Percentile(50)[:2])
+	assert.Equal(t, Series{2, 3}, members.Percentile(25)[:2])
+	assert.Equal(t, Series{5, 9}, members.Percentile(150)[:2]) // clamped to 100
+
+	assert.Equal(t, Series{1.0 / 3, 2.0 / 3}, members.ExceedanceProbability(3)[:2])
+}
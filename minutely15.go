@@ -1,20 +1,60 @@
 package omgo
 
+import "encoding/json"
+
 // Minutely15Data contains 15-minutely weather data.
 // This data is based on NOAA HRRR for North America and
 // DWD ICON-D2 / Météo-France AROME for Central Europe.
+// Missing data points are stored as NaN; see Series.
 type Minutely15Data struct {
 	BaseMetrics // embedded shared fields
 
 	// Lightning potential (HRRR only)
-	LightningPotential []float64 `json:"lightning_potential,omitempty"`
+	LightningPotential Series `json:"lightning_potential,omitempty"`
 
 	// Snowfall height
-	SnowfallHeight []float64 `json:"snowfall_height,omitempty"`
+	SnowfallHeight Series `json:"snowfall_height,omitempty"`
 
 	// Showers (convective precipitation)
-	Showers []float64 `json:"showers,omitempty"`
+	Showers Series `json:"showers,omitempty"`
+
+	// Extra contains returned variables that have no field above.
+	Extra map[string]Series `json:"-"`
+}
+
+// UnmarshalJSON decodes 15-minutely data, keeping unknown variables in Extra.
+func (m *Minutely15Data) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	return m.decodeFields(fields)
+}
+
+// decodeFields decodes the members of a 15-minutely data block.
+func (m *Minutely15Data) decodeFields(fields map[string]json.RawMessage) (err error) {
+	m.Extra, err = decodeFields[Series](fields, m, minutely15Fields())
+	return err
+}
+
+// Variables returns the metrics that were returned, in field order followed
+// by variables in Extra sorted by name.
+func (m *Minutely15Data) Variables() []Minutely15Metric {
+	if m == nil {
+		return nil
+	}
+	return returned[Minutely15Metric](minutely15Fields().returnedSeries(m), m.Extra)
+}
 
-	// Global tilted irradiance instant
-	GlobalTiltedIrradianceInstant []float64 `json:"global_tilted_irradiance_instant,omitempty"`
+// Series returns the values of the given metric and whether it was returned.
+// Variables without a field are looked up in Extra.
+func (m *Minutely15Data) Series(metric Minutely15Metric) (Series, bool) {
+	if m == nil {
+		return nil, false
+	}
+	if s, ok := minutely15Fields().series(m, string(metric)); ok {
+		return s, true
+	}
+	s, ok := m.Extra[string(metric)]
+	return s, ok
 }
@@ -0,0 +1,77 @@
+package omgo
+
+// Model identifies a weather model of the Forecast, Historical Forecast and
+// Previous Runs APIs. Any model name accepted by the API can be used by
+// converting it, e.g. Model("ncep_nbm_conus").
+type Model string
+
+// Weather models available from the Open-Meteo Forecast API.
+const (
+	// ModelBestMatch combines the best models for each location (API default).
+	ModelBestMatch Model = "best_match"
+
+	// ECMWF
+	ModelECMWFIFS025  Model = "ecmwf_ifs025"
+	ModelECMWFAIFS025 Model = "ecmwf_aifs025_single"
+	ModelECMWFIFS     Model = "ecmwf_ifs"
+
+	// DWD ICON
+	ModelICONSeamless Model = "icon_seamless"
+	ModelICONGlobal   Model = "icon_global"
+	ModelICONEU       Model = "icon_eu"
+	ModelICOND2       Model = "icon_d2"
+
+	// NOAA GFS
+	ModelGFSSeamless     Model = "gfs_seamless"
+	ModelGFSGlobal       Model = "gfs_global"
+	ModelGFSHRRR         Model = "gfs_hrrr"
+	ModelGFSGraphCast025 Model = "gfs_graphcast025"
+
+	// Météo-France
+	ModelMeteoFranceSeamless      Model = "meteofrance_seamless"
+	ModelMeteoFranceARPEGEWorld   Model = "meteofrance_arpege_world"
+	ModelMeteoFranceARPEGEEurope  Model = "meteofrance_arpege_europe"
+	ModelMeteoFranceAROMEFrance   Model = "meteofrance_arome_france"
+	ModelMeteoFranceAROMEFranceHD Model = "meteofrance_arome_france_hd"
+
+	// Environment Canada GEM
+	ModelGEMSeamless Model = "gem_seamless"
+	ModelGEMGlobal   Model = "gem_global"
+	ModelGEMRegional Model = "gem_regional"
+	ModelGEMHRDPS    Model = "gem_hrdps_continental"
+
+	// JMA
+	ModelJMASeamless Model = "jma_seamless"
+	ModelJMAMSM      Model = "jma_msm"
+	ModelJMAGSM      Model = "jma_gsm"
+
+	// UK Met Office
+	ModelUKMOSeamless   Model = "ukmo_seamless"
+	ModelUKMOGlobal10km Model = "ukmo_global_deterministic_10km"
+	ModelUKMOUK2km      Model = "ukmo_uk_deterministic_2km"
+
+	// MET Norway
+	ModelMetNoSeamless Model = "metno_seamless"
+	ModelMetNoNordic   Model = "metno_nordic"
+
+	// KNMI and DMI
+	ModelKNMISeamless            Model = "knmi_seamless"
+	ModelKNMIHarmonieAROMEEurope Model = "knmi_harmonie_arome_europe"
+	ModelKNMIHarmonieAROMENL     Model = "knmi_harmonie_arome_netherlands"
+	ModelDMISeamless             Model = "dmi_seamless"
+	ModelDMIHarmonieAROMEEurope  Model = "dmi_harmonie_arome_europe"
+
+	// MeteoSwiss
+	ModelMeteoSwissICONCH1 Model = "meteoswiss_icon_ch1"
+	ModelMeteoSwissICONCH2 Model = "meteoswiss_icon_ch2"
+
+	// Other national weather services
+	ModelBOMAccessGlobal Model = "bom_access_global"
+	ModelCMAGRAPESGlobal Model = "cma_grapes_global"
+	ModelItaliaMeteoICON Model = "italia_meteo_arpae_icon_2i"
+)
+
+// String returns the API parameter string for the model.
+func (m Model) String() string {
+	return string(m)
+}
@@ -0,0 +1,214 @@
+// Package omgoarrow converts omgo weather data to Apache Arrow record batches
+// and Parquet files for analytics pipelines.
+//
+// It is a separate module so the core omgo package stays free of the Arrow
+// dependency tree.
+package omgoarrow
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/hectormalot/omgo"
+)
+
+// Metadata keys of the Arrow schema and fields. The coordinates are those
+// of the response, i.e. of the grid cell the API selected, which can differ
+// from the requested coordinates.
+const (
+	MetadataLatitude  = "latitude"
+	MetadataLongitude = "longitude"
+	MetadataElevation = "elevation"
+	MetadataTimezone  = "timezone"
+	MetadataModel     = "model" // only set for per-model data
+	MetadataUnits     = "units" // JSON object of column name to unit
+	MetadataUnit      = "unit"  // field metadata
+)
+
+// modelTable returns a block of the data of a model of the response.
+func modelTable(w *omgo.Weather, m omgo.Model, block omgo.Block) (*omgo.Table, error) {
+	d := w.ForModel(m)
+	if d == nil {
+		return nil, fmt.Errorf("response has no data for model %q", m)
+	}
+	return d.Table(block)
+}
+
+// Schema returns the Arrow schema of a block of the response: a non-nullable
+// "time" column followed by a nullable column per returned variable. Times
+// are millisecond timestamps in the response's timezone, values are float64
+// and each field carries its unit in the "unit" metadata key. The schema
+// metadata holds the coordinates, timezone and a JSON object of all units.
+func Schema(w *omgo.Weather, block omgo.Block) (*arrow.Schema, error) {
+	table, err := w.Table(block)
+	if err != nil {
+		return nil, err
+	}
+	return schema(w, "", table)
+}
+
+// ModelSchema returns the Arrow schema of a block of the data of a model
+// of a multi-model response; see Schema. The model is stored in the
+// "model" metadata key.
+func ModelSchema(w *omgo.Weather, m omgo.Model, block omgo.Block) (*arrow.Schema, error) {
+	table, err := modelTable(w, m, block)
+	if err != nil {
+		return nil, err
+	}
+	return schema(w, m, table)
+}
+
+func schema(w *omgo.Weather, m omgo.Model, table *omgo.Table) (*arrow.Schema, error) {
+	timestamp := &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: timezone(w)}
+
+	fields := make([]arrow.Field, 0, len(table.Columns)+1)
+	fields = append(fields, arrow.Field{Name: "time", Type: timestamp})
+	units := make(map[string]string, len(table.Columns))
+	for _, c := range table.Columns {
+		f := arrow.Field{Name: c.Name, Type: arrow.PrimitiveTypes.Float64, Nullable: true}
+		if c.Times != nil {
+			f.Type = timestamp
+		}
+		if c.Unit != "" {
+			f.Metadata = arrow.NewMetadata([]string{MetadataUnit}, []string{c.Unit})
+			units[c.Name] = c.Unit
+		}
+		fields = append(fields, f)
+	}
+
+	unitsJSON, err := json.Marshal(units)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{MetadataLatitude, MetadataLongitude, MetadataElevation, MetadataTimezone, MetadataUnits}
+	values := []string{formatFloat(w.Latitude), formatFloat(w.Longitude), formatFloat(w.Elevation), timezone(w), string(unitsJSON)}
+	if m != "" {
+		keys = append(keys, MetadataModel)
+		values = append(values, string(m))
+	}
+	metadata := arrow.NewMetadata(keys, values)
+	return arrow.NewSchema(fields, &metadata), nil
+}
+
+// NewRecordBatch converts a block of the response to an Arrow record batch
+// with the layout described by Schema. Missing values are null. The caller
+// must release the record batch.
+// For multi-model responses the top-level blocks only hold the variables
+// shared by all models; use NewModelRecordBatch to convert a model's data.
+func NewRecordBatch(mem memory.Allocator, w *omgo.Weather, block omgo.Block) (arrow.RecordBatch, error) {
+	table, err := w.Table(block)
+	if err != nil {
+		return nil, err
+	}
+	return newRecordBatch(mem, w, "", table)
+}
+
+// NewModelRecordBatch converts a block of the data of a model of a
+// multi-model response to an Arrow record batch; see NewRecordBatch.
+func NewModelRecordBatch(mem memory.Allocator, w *omgo.Weather, m omgo.Model, block omgo.Block) (arrow.RecordBatch, error) {
+	table, err := modelTable(w, m, block)
+	if err != nil {
+		return nil, err
+	}
+	return newRecordBatch(mem, w, m, table)
+}
+
+func newRecordBatch(mem memory.Allocator, w *omgo.Weather, m omgo.Model, table *omgo.Table) (arrow.RecordBatch, error) {
+	sc, err := schema(w, m, table)
+	if err != nil {
+		return nil, err
+	}
+
+	b := array.NewRecordBuilder(mem, sc)
+	defer b.Release()
+
+	times := table.Times
+	tb := b.Field(0).(*array.TimestampBuilder)
+	tb.Reserve(len(times))
+	for _, t := range times {
+		tb.Append(arrow.Timestamp(t.UnixMilli()))
+	}
+	for j, c := range table.Columns {
+		switch fb := b.Field(j + 1).(type) {
+		case *array.Float64Builder:
+			fb.Reserve(len(times))
+			for i := range times {
+				if c.Values.IsMissing(i) {
+					fb.AppendNull()
+				} else {
+					fb.Append(c.Values[i])
+				}
+			}
+		case *array.TimestampBuilder:
+			fb.Reserve(len(times))
+			for i := range times {
+				if i >= len(c.Times) || c.Times[i].IsZero() {
+					fb.AppendNull()
+				} else {
+					fb.Append(arrow.Timestamp(c.Times[i].UnixMilli()))
+				}
+			}
+		}
+	}
+	return b.NewRecordBatch(), nil
+}
+
+// WriteParquet writes a block of the response as a Snappy-compressed Parquet
+// file. The Arrow schema, including timezone and unit metadata, is stored in
+// the file so readers such as pyarrow and DuckDB restore it.
+func WriteParquet(out io.Writer, w *omgo.Weather, block omgo.Block) error {
+	rec, err := NewRecordBatch(memory.DefaultAllocator, w, block)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+	return writeParquet(out, rec)
+}
+
+// WriteModelParquet writes a block of the data of a model of a multi-model
+// response as a Parquet file; see WriteParquet.
+func WriteModelParquet(out io.Writer, w *omgo.Weather, m omgo.Model, block omgo.Block) error {
+	rec, err := NewModelRecordBatch(memory.DefaultAllocator, w, m, block)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+	return writeParquet(out, rec)
+}
+
+func writeParquet(out io.Writer, rec arrow.RecordBatch) error {
+	fw, err := pqarrow.NewFileWriter(
+		rec.Schema(),
+		out,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+		pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()),
+	)
+	if err != nil {
+		return err
+	}
+	if err := fw.Write(rec); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+// timezone returns the timezone of the timestamp columns, defaulting to UTC.
+func timezone(w *omgo.Weather) string {
+	if w.Timezone == "" {
+		return "UTC"
+	}
+	return w.Timezone
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
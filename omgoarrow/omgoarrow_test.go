@@ -0,0 +1,165 @@
+package omgoarrow
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/hectormalot/omgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWeather(t *testing.T) *omgo.Weather {
+	t.Helper()
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)
+
+	return &omgo.Weather{
+		Latitude:  52.52,
+		Longitude: 13.41,
+		Elevation: 38,
+		Timezone:  "Europe/Berlin",
+		Hourly: &omgo.HourlyData{
+			BaseMetrics: omgo.BaseMetrics{
+				Times:         []time.Time{day, day.Add(time.Hour), day.Add(2 * time.Hour)},
+				Temperature2m: omgo.Series{2.5, math.NaN(), 1.5},
+				WeatherCode:   omgo.WeatherCodes{3, 61, 3},
+			},
+			Extra: map[string]omgo.Series{"boundary_layer_height": {450, 500, 550}},
+		},
+		HourlyUnits: &omgo.HourlyUnits{
+			BaseUnits: omgo.BaseUnits{Temperature2m: "°C", WeatherCode: "wmo code"},
+			Extra:     map[string]string{"boundary_layer_height": "m"},
+		},
+		Daily: &omgo.DailyData{
+			Times:            []time.Time{day},
+			Temperature2mMax: omgo.Series{5.2},
+			Sunrise:          []time.Time{day.Add(8 * time.Hour)},
+		},
+		DailyUnits: &omgo.DailyUnits{Temperature2mMax: "°C", Sunrise: "iso8601"},
+	}
+}
+
+func TestNewRecordBatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec, err := NewRecordBatch(mem, testWeather(t), omgo.BlockHourly)
+	require.NoError(t, err)
+	defer rec.Release()
+
+	schema := rec.Schema()
+	require.Equal(t, 4, schema.NumFields())
+	assert.Equal(t, []string{"time", "temperature_2m", "weather_code", "boundary_layer_height"},
+		[]string{schema.Field(0).Name, schema.Field(1).Name, schema.Field(2).Name, schema.Field(3).Name})
+	assert.Equal(t, int64(3), rec.NumRows())
+
+	// Times are timezone-aware timestamps
+	timeType := schema.Field(0).Type.(*arrow.TimestampType)
+	assert.Equal(t, arrow.Millisecond, timeType.Unit)
+	assert.Equal(t, "Europe/Berlin", timeType.TimeZone)
+	times := rec.Column(0).(*array.Timestamp)
+	assert.Equal(t, time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC).UnixMilli(), int64(times.Value(0)))
+
+	// Missing values are null
+	temps := rec.Column(1).(*array.Float64)
+	assert.Equal(t, 2.5, temps.Value(0))
+	assert.True(t, temps.IsNull(1))
+	assert.Equal(t, 1.5, temps.Value(2))
+	assert.Equal(t, 61.0, rec.Column(2).(*array.Float64).Value(1))
+
+	// Units and coordinates
+	unit, _ := schema.Field(1).Metadata.GetValue(MetadataUnit)
+	assert.Equal(t, "°C", unit)
+	unit, _ = schema.Field(3).Metadata.GetValue(MetadataUnit)
+	assert.Equal(t, "m", unit)
+	metadata := schema.Metadata()
+	lat, _ := metadata.GetValue(MetadataLatitude)
+	assert.Equal(t, "52.52", lat)
+	units, _ := metadata.GetValue(MetadataUnits)
+	assert.JSONEq(t, `{"temperature_2m":"°C","weather_code":"wmo code","boundary_layer_height":"m"}`, units)
+}
+
+func TestNewRecordBatchDaily(t *testing.T) {
+	rec, err := NewRecordBatch(memory.DefaultAllocator, testWeather(t), omgo.BlockDaily)
+	require.NoError(t, err)
+	defer rec.Release()
+
+	schema := rec.Schema()
+	require.Equal(t, 3, schema.NumFields())
+	assert.Equal(t, "sunrise", schema.Field(2).Name)
+	assert.Equal(t, arrow.TIMESTAMP, schema.Field(2).Type.ID())
+	sunrise := rec.Column(2).(*array.Timestamp)
+	assert.Equal(t, time.Date(2024, 1, 15, 7, 0, 0, 0, time.UTC).UnixMilli(), int64(sunrise.Value(0)))
+}
+
+func TestNewRecordBatchErrors(t *testing.T) {
+	weather := testWeather(t)
+
+	_, err := NewRecordBatch(memory.DefaultAllocator, weather, omgo.BlockMinutely15)
+	assert.Error(t, err)
+
+	_, err = NewRecordBatch(memory.DefaultAllocator, weather, omgo.Block("weekly"))
+	assert.Error(t, err)
+}
+
+func TestNewModelRecordBatch(t *testing.T) {
+	weather := testWeather(t)
+	model := &omgo.ModelData{Hourly: weather.Hourly, HourlyUnits: weather.HourlyUnits}
+	weather.Models = map[omgo.Model]*omgo.ModelData{omgo.ModelECMWFIFS025: model}
+	weather.Hourly = &omgo.HourlyData{BaseMetrics: omgo.BaseMetrics{Times: model.Hourly.Times}}
+	weather.HourlyUnits = nil
+
+	rec, err := NewModelRecordBatch(memory.DefaultAllocator, weather, omgo.ModelECMWFIFS025, omgo.BlockHourly)
+	require.NoError(t, err)
+	defer rec.Release()
+
+	schema := rec.Schema()
+	require.Equal(t, 4, schema.NumFields())
+	assert.Equal(t, 2.5, rec.Column(1).(*array.Float64).Value(0))
+	name, _ := schema.Metadata().GetValue(MetadataModel)
+	assert.Equal(t, "ecmwf_ifs025", name)
+
+	// Model not in the response
+	_, err = NewModelRecordBatch(memory.DefaultAllocator, weather, omgo.ModelGFSSeamless, omgo.BlockHourly)
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteModelParquet(&buf, weather, omgo.ModelECMWFIFS025, omgo.BlockHourly))
+}
+
+func TestWriteParquet(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteParquet(&buf, testWeather(t), omgo.BlockHourly))
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf.Bytes()), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	defer table.Release()
+
+	assert.Equal(t, int64(3), table.NumRows())
+	schema := table.Schema()
+	assert.Equal(t, "Europe/Berlin", schema.Field(0).Type.(*arrow.TimestampType).TimeZone)
+	unit, _ := schema.Field(1).Metadata.GetValue(MetadataUnit)
+	assert.Equal(t, "°C", unit)
+
+	temps := table.Column(1).Data().Chunk(0).(*array.Float64)
+	assert.Equal(t, 2.5, temps.Value(0))
+	assert.True(t, temps.IsNull(1))
+
+	// Coordinates are readable from the Parquet key-value metadata
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+	lon := reader.MetaData().KeyValueMetadata().FindValue(MetadataLongitude)
+	require.NotNil(t, lon)
+	assert.Equal(t, "13.41", *lon)
+}
@@ -0,0 +1,228 @@
+// Package omgoprom exposes current Open-Meteo conditions for a set of
+// locations as Prometheus gauges.
+//
+// It is a separate module so the core omgo package stays free of the
+// Prometheus client dependencies.
+package omgoprom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hectormalot/omgo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace is the prefix of all exported metric names.
+const Namespace = "openmeteo"
+
+// Location is a named location whose current conditions are exported.
+// The name is used as the "location" label.
+type Location struct {
+	Name string
+	omgo.Location
+}
+
+// Collector is a prometheus.Collector exposing the latest current conditions
+// of its locations as gauges named openmeteo_current_<metric>, labeled with
+// location, latitude and longitude.
+//
+// The API is queried by Update, not on scrape, so scrape frequency doesn't
+// count against the API quota. Use Run to update periodically.
+type Collector struct {
+	client    *omgo.Client
+	req       *omgo.ForecastRequest
+	locations []Location
+	metrics   []omgo.CurrentMetric
+
+	descs    []*prometheus.Desc // per metric
+	timeDesc *prometheus.Desc
+	upDesc   *prometheus.Desc
+	labels   [][]string // per location
+	updateMu sync.Mutex // serializes Update
+
+	mu      sync.RWMutex
+	current []*omgo.CurrentData // per location, from the last successful update
+	up      bool
+}
+
+// NewCollector creates a Collector for the given current metrics and
+// locations. All locations are fetched with a single API call. Repeated
+// metrics are ignored; location names must be unique.
+func NewCollector(client *omgo.Client, metrics []omgo.CurrentMetric, locations ...Location) (*Collector, error) {
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("at least one metric is required")
+	}
+	metrics, err := uniqueMetrics(metrics)
+	if err != nil {
+		return nil, err
+	}
+	locs := make([]omgo.Location, len(locations))
+	names := make(map[string]bool, len(locations))
+	for i, l := range locations {
+		if l.Name == "" {
+			return nil, fmt.Errorf("location %d has no name", i)
+		}
+		if names[l.Name] {
+			return nil, fmt.Errorf("duplicate location name %q", l.Name)
+		}
+		names[l.Name] = true
+		locs[i] = l.Location
+	}
+	req, err := omgo.NewForecastRequestForLocations(locs...)
+	if err != nil {
+		return nil, err
+	}
+	req.WithCurrent(metrics...)
+
+	labelNames := []string{"location", "latitude", "longitude"}
+	c := &Collector{
+		client:    client,
+		req:       req,
+		locations: locations,
+		metrics:   metrics,
+		timeDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "current", "time_seconds"),
+			"Time of the current conditions as a Unix timestamp.",
+			labelNames, nil,
+		),
+		upDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", "up"),
+			"Whether the last update from the Open-Meteo API succeeded.",
+			nil, nil,
+		),
+	}
+	for _, m := range metrics {
+		c.descs = append(c.descs, prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "current", metricName(m)),
+			fmt.Sprintf("Current %s from the Open-Meteo API.", m),
+			labelNames, nil,
+		))
+	}
+	for _, l := range locations {
+		c.labels = append(c.labels, []string{l.Name, formatFloat(l.Latitude), formatFloat(l.Longitude)})
+	}
+	return c, nil
+}
+
+// Update fetches the current conditions of all locations. On failure the
+// previous values are kept and openmeteo_up is set to 0.
+func (c *Collector) Update(ctx context.Context) error {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	weathers, err := c.client.ForecastMulti(ctx, c.req)
+	if err != nil {
+		c.mu.Lock()
+		c.up = false
+		c.mu.Unlock()
+		return err
+	}
+
+	current := make([]*omgo.CurrentData, len(weathers))
+	for i, w := range weathers {
+		current[i] = w.Current
+	}
+	c.mu.Lock()
+	c.current = current
+	c.up = true
+	c.mu.Unlock()
+	return nil
+}
+
+// Run calls Update immediately and then at every interval until ctx is done,
+// returning ctx's error. Errors of Update are passed to onError if it is not
+// nil. Run returns an error at once if interval is not positive.
+func (c *Collector) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := c.Update(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range c.descs {
+		ch <- d
+	}
+	ch <- c.timeDesc
+	ch <- c.upDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	current, up := c.current, c.up
+	c.mu.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(c.upDesc, prometheus.GaugeValue, boolToFloat(up))
+	for i, data := range current {
+		if data == nil {
+			continue
+		}
+		labels := c.labels[i]
+		if !data.Time.IsZero() {
+			ch <- prometheus.MustNewConstMetric(c.timeDesc, prometheus.GaugeValue, float64(data.Time.Unix()), labels...)
+		}
+		for j, m := range c.metrics {
+			if v, ok := data.Value(m); ok {
+				ch <- prometheus.MustNewConstMetric(c.descs[j], prometheus.GaugeValue, v, labels...)
+			}
+		}
+	}
+}
+
+// uniqueMetrics returns the metrics without repeats, or an error if distinct
+// metrics map to the same metric name.
+func uniqueMetrics(metrics []omgo.CurrentMetric) ([]omgo.CurrentMetric, error) {
+	unique := make([]omgo.CurrentMetric, 0, len(metrics))
+	seen := make(map[string]omgo.CurrentMetric, len(metrics))
+	for _, m := range metrics {
+		name := metricName(m)
+		if prev, ok := seen[name]; ok {
+			if prev != m {
+				return nil, fmt.Errorf("metrics %q and %q have the same name %q", prev, m, name)
+			}
+			continue
+		}
+		seen[name] = m
+		unique = append(unique, m)
+	}
+	return unique, nil
+}
+
+// metricName converts a metric to a valid Prometheus metric name component.
+func metricName(m omgo.CurrentMetric) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, string(m))
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
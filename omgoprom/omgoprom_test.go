@@ -0,0 +1,141 @@
+package omgoprom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hectormalot/omgo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResponse = `[
+  {
+    "latitude": 52.52, "longitude": 13.419998, "elevation": 38.0,
+    "utc_offset_seconds": 0, "timezone": "GMT", "timezone_abbreviation": "GMT",
+    "current": {"time": "2024-01-15T14:00", "interval": 900, "temperature_2m": 3.5, "weather_code": 61}
+  },
+  {
+    "latitude": 48.84, "longitude": 2.3599997, "elevation": 43.0,
+    "utc_offset_seconds": 0, "timezone": "GMT", "timezone_abbreviation": "GMT",
+    "current": {"time": "2024-01-15T14:00", "interval": 900, "temperature_2m": 7.25}
+  }
+]`
+
+func testCollector(t *testing.T, status int, body string) (*Collector, *string) {
+	t.Helper()
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := omgo.NewClient(omgo.WithForecastURL(server.URL))
+	collector, err := NewCollector(client,
+		[]omgo.CurrentMetric{omgo.CurrentTemperature2m, omgo.CurrentWeatherCode},
+		Location{Name: "berlin", Location: omgo.Location{Latitude: 52.52, Longitude: 13.41}},
+		Location{Name: "paris", Location: omgo.Location{Latitude: 48.85, Longitude: 2.35}},
+	)
+	require.NoError(t, err)
+	return collector, &gotQuery
+}
+
+func TestCollector(t *testing.T) {
+	collector, query := testCollector(t, http.StatusOK, testResponse)
+	require.NoError(t, collector.Update(context.Background()))
+	assert.Contains(t, *query, "current=temperature_2m%2Cweather_code")
+
+	expected := `
+# HELP openmeteo_current_temperature_2m Current temperature_2m from the Open-Meteo API.
+# TYPE openmeteo_current_temperature_2m gauge
+openmeteo_current_temperature_2m{latitude="48.85",location="paris",longitude="2.35"} 7.25
+openmeteo_current_temperature_2m{latitude="52.52",location="berlin",longitude="13.41"} 3.5
+# HELP openmeteo_current_weather_code Current weather_code from the Open-Meteo API.
+# TYPE openmeteo_current_weather_code gauge
+openmeteo_current_weather_code{latitude="52.52",location="berlin",longitude="13.41"} 61
+# HELP openmeteo_current_time_seconds Time of the current conditions as a Unix timestamp.
+# TYPE openmeteo_current_time_seconds gauge
+openmeteo_current_time_seconds{latitude="48.85",location="paris",longitude="2.35"} 1.7053272e+09
+openmeteo_current_time_seconds{latitude="52.52",location="berlin",longitude="13.41"} 1.7053272e+09
+# HELP openmeteo_up Whether the last update from the Open-Meteo API succeeded.
+# TYPE openmeteo_up gauge
+openmeteo_up 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestCollectorUpdateError(t *testing.T) {
+	collector, _ := testCollector(t, http.StatusBadRequest, `{"error": true, "reason": "Invalid location"}`)
+
+	assert.Error(t, collector.Update(context.Background()))
+	expected := `
+# HELP openmeteo_up Whether the last update from the Open-Meteo API succeeded.
+# TYPE openmeteo_up gauge
+openmeteo_up 0
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestNewCollectorErrors(t *testing.T) {
+	client := omgo.NewClient()
+	berlin := Location{Name: "berlin", Location: omgo.Location{Latitude: 52.52, Longitude: 13.41}}
+
+	_, err := NewCollector(client, nil, berlin)
+	assert.Error(t, err)
+
+	_, err = NewCollector(client, []omgo.CurrentMetric{omgo.CurrentTemperature2m})
+	assert.Error(t, err)
+
+	_, err = NewCollector(client, []omgo.CurrentMetric{omgo.CurrentTemperature2m}, Location{Location: berlin.Location})
+	assert.Error(t, err)
+
+	_, err = NewCollector(client, []omgo.CurrentMetric{omgo.CurrentTemperature2m}, Location{Name: "nowhere", Location: omgo.Location{Latitude: 95}})
+	assert.Error(t, err)
+
+	// Location names are label values and must be unique
+	_, err = NewCollector(client, []omgo.CurrentMetric{omgo.CurrentTemperature2m}, berlin, berlin)
+	assert.ErrorContains(t, err, "duplicate location name")
+
+	// Distinct metrics must not share a metric name
+	_, err = NewCollector(client, []omgo.CurrentMetric{"a_b", "a-b"}, berlin)
+	assert.Error(t, err)
+}
+
+func TestNewCollectorDuplicateMetrics(t *testing.T) {
+	client := omgo.NewClient()
+	berlin := Location{Name: "berlin", Location: omgo.Location{Latitude: 52.52, Longitude: 13.41}}
+
+	collector, err := NewCollector(client,
+		[]omgo.CurrentMetric{omgo.CurrentTemperature2m, omgo.CurrentWeatherCode, omgo.CurrentTemperature2m}, berlin)
+	require.NoError(t, err)
+	assert.Equal(t, []omgo.CurrentMetric{omgo.CurrentTemperature2m, omgo.CurrentWeatherCode}, collector.metrics)
+
+	reg := prometheus.NewRegistry()
+	assert.NoError(t, reg.Register(collector))
+}
+
+func TestCollectorRun(t *testing.T) {
+	collector, _ := testCollector(t, http.StatusOK, testResponse)
+
+	err := collector.Run(context.Background(), 0, nil)
+	assert.ErrorContains(t, err, "interval must be positive")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = collector.Run(ctx, time.Minute, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMetricName(t *testing.T) {
+	assert.Equal(t, "temperature_2m", metricName(omgo.CurrentTemperature2m))
+	assert.Equal(t, "soil_moisture_0_to_1cm", metricName("soil_moisture_0_to_1cm"))
+	assert.Equal(t, "a_b", metricName("a-b"))
+}
@@ -1,13 +1,19 @@
 package omgo
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
-// rawResponse represents the raw JSON response from the API.
-// This is used as an intermediate step for parsing.
-type rawResponse struct {
+// rawMeta contains the location and timezone fields shared by all
+// time series APIs (forecast, archive, air quality, marine, ...).
+type rawMeta struct {
 	Latitude             float64 `json:"latitude"`
 	Longitude            float64 `json:"longitude"`
 	Elevation            float64 `json:"elevation"`
@@ -15,6 +21,26 @@ type rawResponse struct {
 	TimezoneAbbreviation string  `json:"timezone_abbreviation"`
 	UTCOffsetSeconds     int     `json:"utc_offset_seconds"`
 	GenerationTimeMs     float64 `json:"generationtime_ms"`
+}
+
+// timeLocation loads the response timezone for time parsing.
+// Returns nil (treated as UTC) if no timezone is set.
+func (m rawMeta) timeLocation() *time.Location {
+	if m.Timezone == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		// Fall back to UTC if timezone is invalid
+		return time.UTC
+	}
+	return loc
+}
+
+// rawResponse represents the raw JSON response from the API.
+// This is used as an intermediate step for parsing.
+type rawResponse struct {
+	rawMeta
 
 	Current      json.RawMessage `json:"current,omitempty"`
 	CurrentUnits *CurrentUnits   `json:"current_units,omitempty"`
@@ -29,64 +55,16 @@ type rawResponse struct {
 	DailyUnits *DailyUnits     `json:"daily_units,omitempty"`
 }
 
-// rawCurrent represents the raw current weather data.
-type rawCurrent struct {
-	Time                string       `json:"time"`
-	Interval            int          `json:"interval"`
-	Temperature2m       *float64     `json:"temperature_2m,omitempty"`
-	RelativeHumidity2m  *float64     `json:"relative_humidity_2m,omitempty"`
-	ApparentTemperature *float64     `json:"apparent_temperature,omitempty"`
-	IsDay               *int         `json:"is_day,omitempty"`
-	Precipitation       *float64     `json:"precipitation,omitempty"`
-	Rain                *float64     `json:"rain,omitempty"`
-	Showers             *float64     `json:"showers,omitempty"`
-	Snowfall            *float64     `json:"snowfall,omitempty"`
-	WeatherCode         *WeatherCode `json:"weather_code,omitempty"`
-	CloudCover          *float64     `json:"cloud_cover,omitempty"`
-	PressureMSL         *float64     `json:"pressure_msl,omitempty"`
-	SurfacePressure     *float64     `json:"surface_pressure,omitempty"`
-	WindSpeed10m        *float64     `json:"wind_speed_10m,omitempty"`
-	WindDirection10m    *float64     `json:"wind_direction_10m,omitempty"`
-	WindGusts10m        *float64     `json:"wind_gusts_10m,omitempty"`
-}
-
-// rawHourly represents the raw hourly data with time as strings.
-type rawHourly struct {
-	Time []string `json:"time"`
-	// All other fields will be unmarshaled directly into HourlyData
-}
-
-// rawDaily represents the raw daily data with time and sun times as strings.
-type rawDaily struct {
-	Time    []string `json:"time"`
-	Sunrise []string `json:"sunrise,omitempty"`
-	Sunset  []string `json:"sunset,omitempty"`
-	// All other fields will be unmarshaled directly into DailyData
-}
-
-// rawMinutely15 represents the raw 15-minutely data with time as strings.
-type rawMinutely15 struct {
-	Time []string `json:"time"`
-	// All other fields will be unmarshaled directly into Minutely15Data
-}
-
 // parseWeatherResponse parses the API response into a Weather struct.
-func parseWeatherResponse(body []byte) (*Weather, error) {
+// The requested models, if any, are used to fill Weather.Models.
+func parseWeatherResponse(body []byte, models ...Model) (*Weather, error) {
 	var raw rawResponse
 	if err := json.Unmarshal(body, &raw); err != nil {
-		return nil, err
+		return nil, &DecodeError{Err: err}
 	}
 
 	// Load timezone for proper time parsing
-	var loc *time.Location
-	if raw.Timezone != "" {
-		var err error
-		loc, err = time.LoadLocation(raw.Timezone)
-		if err != nil {
-			// Fall back to UTC if timezone is invalid
-			loc = time.UTC
-		}
-	}
+	loc := raw.timeLocation()
 
 	weather := &Weather{
 		Latitude:             raw.Latitude,
@@ -106,7 +84,7 @@ func parseWeatherResponse(body []byte) (*Weather, error) {
 	if len(raw.Current) > 0 {
 		current, err := parseCurrent(raw.Current, loc)
 		if err != nil {
-			return nil, err
+			return nil, &DecodeError{Block: "current", Err: err}
 		}
 		weather.Current = current
 	}
@@ -115,7 +93,7 @@ func parseWeatherResponse(body []byte) (*Weather, error) {
 	if len(raw.Hourly) > 0 {
 		hourly, err := parseHourly(raw.Hourly, loc)
 		if err != nil {
-			return nil, err
+			return nil, &DecodeError{Block: "hourly", Err: err}
 		}
 		weather.Hourly = hourly
 	}
@@ -124,7 +102,7 @@ func parseWeatherResponse(body []byte) (*Weather, error) {
 	if len(raw.Minutely15) > 0 {
 		minutely15, err := parseMinutely15(raw.Minutely15, loc)
 		if err != nil {
-			return nil, err
+			return nil, &DecodeError{Block: "minutely_15", Err: err}
 		}
 		weather.Minutely15 = minutely15
 	}
@@ -133,132 +111,475 @@ func parseWeatherResponse(body []byte) (*Weather, error) {
 	if len(raw.Daily) > 0 {
 		daily, err := parseDaily(raw.Daily, loc)
 		if err != nil {
-			return nil, err
+			return nil, &DecodeError{Block: "daily", Err: err}
 		}
 		weather.Daily = daily
 	}
 
+	// Split per-model data
+	switch {
+	case len(models) == 1:
+		weather.Models = map[Model]*ModelData{models[0]: weather.data()}
+	case len(models) > 1:
+		modelData, err := parseModelData(body, loc, models)
+		if err != nil {
+			return nil, err
+		}
+		weather.Models = modelData
+		weather.dropModelVariables(models)
+	}
+
 	return weather, nil
 }
 
-// parseCurrent parses current weather data.
-func parseCurrent(data json.RawMessage, loc *time.Location) (*CurrentData, error) {
-	var raw rawCurrent
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return nil, err
+// dropModelVariables removes the variables suffixed with a model name from
+// the Extra maps of the combined data, as they belong to that model's data.
+func (w *Weather) dropModelVariables(models []Model) {
+	if w.Current != nil {
+		w.Current.Extra = withoutModelVariables(w.Current.Extra, models)
+	}
+	if w.CurrentUnits != nil {
+		w.CurrentUnits.Extra = withoutModelVariables(w.CurrentUnits.Extra, models)
+	}
+	if w.Hourly != nil {
+		w.Hourly.Extra = withoutModelVariables(w.Hourly.Extra, models)
+	}
+	if w.HourlyUnits != nil {
+		w.HourlyUnits.Extra = withoutModelVariables(w.HourlyUnits.Extra, models)
+	}
+	if w.Minutely15 != nil {
+		w.Minutely15.Extra = withoutModelVariables(w.Minutely15.Extra, models)
 	}
+	if w.Minutely15Units != nil {
+		w.Minutely15Units.Extra = withoutModelVariables(w.Minutely15Units.Extra, models)
+	}
+	if w.Daily != nil {
+		w.Daily.Extra = withoutModelVariables(w.Daily.Extra, models)
+	}
+	if w.DailyUnits != nil {
+		w.DailyUnits.Extra = withoutModelVariables(w.DailyUnits.Extra, models)
+	}
+}
+
+// withoutModelVariables deletes the variables suffixed with the name of one
+// of models from extra. It returns nil if no variables remain.
+func withoutModelVariables[V any](extra map[string]V, models []Model) map[string]V {
+	for name := range extra {
+		for _, m := range models {
+			if strings.HasSuffix(name, "_"+string(m)) {
+				delete(extra, name)
+				break
+			}
+		}
+	}
+	if len(extra) == 0 {
+		return nil
+	}
+	return extra
+}
+
+// rawModelBlocks contains the raw data and unit blocks of a response,
+// used to split a multi-model response by model.
+type rawModelBlocks struct {
+	Current         json.RawMessage `json:"current,omitempty"`
+	CurrentUnits    json.RawMessage `json:"current_units,omitempty"`
+	Hourly          json.RawMessage `json:"hourly,omitempty"`
+	HourlyUnits     json.RawMessage `json:"hourly_units,omitempty"`
+	Minutely15      json.RawMessage `json:"minutely_15,omitempty"`
+	Minutely15Units json.RawMessage `json:"minutely_15_units,omitempty"`
+	Daily           json.RawMessage `json:"daily,omitempty"`
+	DailyUnits      json.RawMessage `json:"daily_units,omitempty"`
+}
 
-	t, err := parseDateTime(raw.Time, loc)
+// parseModelData parses the data of each model from a multi-model response.
+func parseModelData(body []byte, loc *time.Location, models []Model) (map[Model]*ModelData, error) {
+	var raw rawModelBlocks
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	names := make([]string, len(models))
+	result := make(map[Model]*ModelData, len(models))
+	for i, m := range models {
+		names[i] = string(m)
+		result[m] = &ModelData{}
+	}
+
+	// eachModel calls fn with the block of every model, if data is present.
+	eachModel := func(name string, data json.RawMessage, fn func(d *ModelData, block json.RawMessage) error) error {
+		if len(data) == 0 {
+			return nil
+		}
+		blocks, err := splitModelBlock(data, names)
+		if err != nil {
+			return &DecodeError{Block: name, Err: err}
+		}
+		for model, block := range blocks {
+			if err := fn(result[Model(model)], block); err != nil {
+				return &DecodeError{Block: name, Model: Model(model), Err: err}
+			}
+		}
+		return nil
+	}
+
+	err := errors.Join(
+		eachModel("current", raw.Current, func(d *ModelData, block json.RawMessage) (err error) {
+			d.Current, err = parseCurrent(block, loc)
+			return err
+		}),
+		eachModel("current_units", raw.CurrentUnits, func(d *ModelData, block json.RawMessage) error {
+			d.CurrentUnits = &CurrentUnits{}
+			return json.Unmarshal(block, d.CurrentUnits)
+		}),
+		eachModel("hourly", raw.Hourly, func(d *ModelData, block json.RawMessage) (err error) {
+			d.Hourly, err = parseHourly(block, loc)
+			return err
+		}),
+		eachModel("hourly_units", raw.HourlyUnits, func(d *ModelData, block json.RawMessage) error {
+			d.HourlyUnits = &HourlyUnits{}
+			return json.Unmarshal(block, d.HourlyUnits)
+		}),
+		eachModel("minutely_15", raw.Minutely15, func(d *ModelData, block json.RawMessage) (err error) {
+			d.Minutely15, err = parseMinutely15(block, loc)
+			return err
+		}),
+		eachModel("minutely_15_units", raw.Minutely15Units, func(d *ModelData, block json.RawMessage) error {
+			d.Minutely15Units = &Minutely15Units{}
+			return json.Unmarshal(block, d.Minutely15Units)
+		}),
+		eachModel("daily", raw.Daily, func(d *ModelData, block json.RawMessage) (err error) {
+			d.Daily, err = parseDaily(block, loc)
+			return err
+		}),
+		eachModel("daily_units", raw.DailyUnits, func(d *ModelData, block json.RawMessage) error {
+			d.DailyUnits = &DailyUnits{}
+			return json.Unmarshal(block, d.DailyUnits)
+		}),
+	)
 	if err != nil {
 		return nil, err
 	}
+	return result, nil
+}
+
+// parseMultiWeatherResponse parses a response for a multi-location request.
+// The API returns a JSON array with one object per location, or a single
+// object when only one location was requested.
+func parseMultiWeatherResponse(body []byte, expected int, models ...Model) ([]*Weather, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		if expected != 1 {
+			return nil, &DecodeError{Err: fmt.Errorf("expected %d locations in response, got 1", expected)}
+		}
+		weather, err := parseWeatherResponse(body, models...)
+		if err != nil {
+			return nil, err
+		}
+		return []*Weather{weather}, nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(trimmed, &items); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+	if len(items) != expected {
+		return nil, &DecodeError{Err: fmt.Errorf("expected %d locations in response, got %d", expected, len(items))}
+	}
 
-	return &CurrentData{
-		Time:                t,
-		Interval:            raw.Interval,
-		Temperature2m:       raw.Temperature2m,
-		RelativeHumidity2m:  raw.RelativeHumidity2m,
-		ApparentTemperature: raw.ApparentTemperature,
-		IsDay:               raw.IsDay,
-		Precipitation:       raw.Precipitation,
-		Rain:                raw.Rain,
-		Showers:             raw.Showers,
-		Snowfall:            raw.Snowfall,
-		WeatherCode:         raw.WeatherCode,
-		CloudCover:          raw.CloudCover,
-		PressureMSL:         raw.PressureMSL,
-		SurfacePressure:     raw.SurfacePressure,
-		WindSpeed10m:        raw.WindSpeed10m,
-		WindDirection10m:    raw.WindDirection10m,
-		WindGusts10m:        raw.WindGusts10m,
-	}, nil
+	result := make([]*Weather, len(items))
+	for i, item := range items {
+		weather, err := parseWeatherResponse(item, models...)
+		if err != nil {
+			return nil, fmt.Errorf("parsing location %d: %w", i, err)
+		}
+		result[i] = weather
+	}
+	return result, nil
 }
 
-// parseHourly parses hourly weather data.
-func parseHourly(data json.RawMessage, loc *time.Location) (*HourlyData, error) {
-	// First, parse just the time array
-	var rawTime rawHourly
-	if err := json.Unmarshal(data, &rawTime); err != nil {
+// parseCurrent parses current weather data.
+func parseCurrent(data json.RawMessage, loc *time.Location) (*CurrentData, error) {
+	fields, err := unmarshalFields(data)
+	if err != nil {
 		return nil, err
 	}
 
-	// Parse times
-	times, err := parseDateTimeArray(rawTime.Time, loc)
+	var rawTime string
+	if err := json.Unmarshal(fields["time"], &rawTime); err != nil {
+		return nil, err
+	}
+	t, err := parseDateTime(rawTime, loc)
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse all other fields into HourlyData
-	hourly := &HourlyData{}
-	if err := json.Unmarshal(data, hourly); err != nil {
+	current := &CurrentData{Time: t}
+	current.Extra, err = decodeFields[float64](fields, current, currentFields())
+	if err != nil {
 		return nil, err
 	}
+	return current, nil
+}
 
+// parseHourly parses hourly weather data.
+func parseHourly(data json.RawMessage, loc *time.Location) (*HourlyData, error) {
+	hourly := &HourlyData{}
+	times, err := parseTimeBlock(data, loc, hourly)
+	if err != nil {
+		return nil, err
+	}
 	hourly.Times = times
 	return hourly, nil
 }
 
 // parseMinutely15 parses 15-minutely weather data.
 func parseMinutely15(data json.RawMessage, loc *time.Location) (*Minutely15Data, error) {
-	// First, parse just the time array
-	var rawTime rawMinutely15
-	if err := json.Unmarshal(data, &rawTime); err != nil {
+	minutely15 := &Minutely15Data{}
+	times, err := parseTimeBlock(data, loc, minutely15)
+	if err != nil {
 		return nil, err
 	}
+	minutely15.Times = times
+	return minutely15, nil
+}
+
+// parseTimeBlock parses a time series block with datetime timestamps.
+// The "time" array is parsed and returned; all other fields are
+// decoded into dst.
+func parseTimeBlock(data json.RawMessage, loc *time.Location, dst any) ([]time.Time, error) {
+	return parseBlock(data, loc, dst, parseDateTimeArray)
+}
+
+// parseDateBlock parses a daily time series block with date timestamps.
+// The "time" array is parsed and returned; all other fields are
+// decoded into dst.
+func parseDateBlock(data json.RawMessage, loc *time.Location, dst any) ([]time.Time, error) {
+	return parseBlock(data, loc, dst, parseDateArray)
+}
+
+// fieldDecoder is implemented by data blocks that decode their fields from
+// the members of a JSON object, so a block is decoded only once.
+type fieldDecoder interface {
+	decodeFields(fields map[string]json.RawMessage) error
+}
 
-	// Parse times
-	times, err := parseDateTimeArray(rawTime.Time, loc)
+// parseBlock parses a time series block, using parseTimes to decode the
+// "time" array. The other fields are decoded into dst.
+func parseBlock(data json.RawMessage, loc *time.Location, dst any,
+	parseTimes func([]string, *time.Location) ([]time.Time, error)) ([]time.Time, error) {
+	fields, err := unmarshalFields(data)
 	if err != nil {
 		return nil, err
 	}
-
-	// Parse all other fields into Minutely15Data
-	minutely15 := &Minutely15Data{}
-	if err := json.Unmarshal(data, minutely15); err != nil {
+	times, err := parseFieldTimes(fields, loc, parseTimes)
+	if err != nil {
 		return nil, err
 	}
 
-	minutely15.Times = times
-	return minutely15, nil
+	if d, ok := dst.(fieldDecoder); ok {
+		err = d.decodeFields(fields)
+	} else {
+		err = json.Unmarshal(data, dst)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return times, nil
 }
 
 // parseDaily parses daily weather data.
 func parseDaily(data json.RawMessage, loc *time.Location) (*DailyData, error) {
-	// First, parse time and sun times
-	var rawTime rawDaily
-	if err := json.Unmarshal(data, &rawTime); err != nil {
+	fields, err := unmarshalFields(data)
+	if err != nil {
 		return nil, err
 	}
 
 	// Parse dates
-	times, err := parseDateArray(rawTime.Time, loc)
+	times, err := parseFieldTimes(fields, loc, parseDateArray)
 	if err != nil {
 		return nil, err
 	}
+	daily := &DailyData{Times: times}
+
+	// Parse sunrise/sunset times
+	for name, dst := range map[string]*[]time.Time{"sunrise": &daily.Sunrise, "sunset": &daily.Sunset} {
+		raw, ok := fields[name]
+		if !ok {
+			continue
+		}
+		delete(fields, name)
+		var values []string
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, err
+		}
+		if len(values) > 0 {
+			if *dst, err = parseDateTimeArray(values, loc); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	// Parse all other fields into DailyData
-	daily := &DailyData{}
-	if err := json.Unmarshal(data, daily); err != nil {
+	if err := daily.decodeFields(fields); err != nil {
 		return nil, err
 	}
+	return daily, nil
+}
 
-	daily.Times = times
+// memberSuffix is appended by the API to ensemble member variables,
+// followed by a two-digit member number (e.g. "river_discharge_member01").
+const memberSuffix = "_member"
+
+// maxMembers bounds the member numbers accepted in a response. It is well
+// above the largest ensemble offered by the API (50 members and a control
+// run), and keeps a malformed key from allocating an arbitrarily large slice.
+const maxMembers = 100
+
+// previousDaySuffix is appended by the Previous Runs API to variables from
+// earlier model runs, followed by the number of days (e.g. "temperature_2m_previous_day1").
+const previousDaySuffix = "_previous_day"
+
+// splitIndexedKey splits a variable name into its base name and the number
+// following suffix. Variables without the suffix have index 0.
+func splitIndexedKey(key, suffix string) (string, int) {
+	i := strings.LastIndex(key, suffix)
+	if i < 0 {
+		return key, 0
+	}
+	n, err := strconv.Atoi(key[i+len(suffix):])
+	if err != nil || n < 1 {
+		return key, 0
+	}
+	return key[:i], n
+}
 
-	// Parse sunrise/sunset times
-	if len(rawTime.Sunrise) > 0 {
-		sunrise, err := parseDateTimeArray(rawTime.Sunrise, loc)
-		if err != nil {
-			return nil, err
+// groupIndexed collects the variables of a time series block by base name.
+// A variable without suffix is stored at index 0 and "<name><suffix>N" at
+// index N, which must not exceed maxIndex. The "time" field is skipped.
+func groupIndexed[S ~[]Series](fields map[string]json.RawMessage, suffix string, maxIndex int) (map[string]S, error) {
+	groups := make(map[string]S)
+	for key, raw := range fields {
+		if key == "time" {
+			continue
+		}
+		var s Series
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", key, err)
+		}
+		name, n := splitIndexedKey(key, suffix)
+		if n > maxIndex {
+			return nil, fmt.Errorf("parsing %s: index %d out of range (max %d)", key, n, maxIndex)
+		}
+		group := groups[name]
+		if n >= len(group) {
+			group = append(group, make(S, n+1-len(group))...)
+		}
+		group[n] = s
+		groups[name] = group
+	}
+	return groups, nil
+}
+
+// groupMembers collects the variables of a time series block by base name.
+// Each variable becomes an EnsembleMembers with the control run at index 0
+// and member n at index n. The "time" field is skipped.
+func groupMembers(fields map[string]json.RawMessage) (map[string]EnsembleMembers, error) {
+	return groupIndexed[EnsembleMembers](fields, memberSuffix, maxMembers)
+}
+
+// parseIndexedBlock groups the variables of a time series block by metric
+// (see groupIndexed), and picks the unit string of each variable from the
+// matching units block.
+func parseIndexedBlock[M ~string, S ~[]Series](fields map[string]json.RawMessage, units map[string]string, suffix string, maxIndex int) (map[M]S, map[M]string, error) {
+	groups, err := groupIndexed[S](fields, suffix, maxIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variables := make(map[M]S, len(groups))
+	for name, group := range groups {
+		variables[M(name)] = group
+	}
+	variableUnits := make(map[M]string, len(groups))
+	for key, unit := range units {
+		name, _ := splitIndexedKey(key, suffix)
+		if _, ok := groups[name]; ok {
+			variableUnits[M(name)] = unit
+		}
+	}
+	return variables, variableUnits, nil
+}
+
+// parseMemberBlock groups the variables of a time series block into ensemble
+// members keyed by metric, along with their units.
+func parseMemberBlock[M ~string](fields map[string]json.RawMessage, units map[string]string) (map[M]EnsembleMembers, map[M]string, error) {
+	return parseIndexedBlock[M, EnsembleMembers](fields, units, memberSuffix, maxMembers)
+}
+
+// parseFieldTimes parses the "time" field of a decoded time series block,
+// using parse to decode the individual timestamps.
+func parseFieldTimes(fields map[string]json.RawMessage, loc *time.Location,
+	parse func([]string, *time.Location) ([]time.Time, error)) ([]time.Time, error) {
+	var rawTime []string
+	if err := json.Unmarshal(fields["time"], &rawTime); err != nil {
+		return nil, err
+	}
+	return parse(rawTime, loc)
+}
+
+// splitModelFields splits the fields of a block by model. When several
+// models are requested the API appends "_<model>" to every variable; with a
+// single model the fields are returned unchanged under that model. Fields
+// without a model suffix, such as "time", are shared and copied into every
+// group.
+func splitModelFields[V any](fields map[string]V, models []string) map[string]map[string]V {
+	result := make(map[string]map[string]V, len(models))
+	if len(models) == 1 {
+		result[models[0]] = fields
+		return result
+	}
+
+	// Match longer names first in case one model name ends with another
+	sorted := slices.Clone(models)
+	slices.SortFunc(sorted, func(a, b string) int { return len(b) - len(a) })
+	for _, model := range sorted {
+		result[model] = make(map[string]V)
+	}
+
+	for key, value := range fields {
+		matched := false
+		for _, model := range sorted {
+			if name, ok := strings.CutSuffix(key, "_"+model); ok {
+				result[model][name] = value
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			for _, model := range sorted {
+				result[model][key] = value
+			}
 		}
-		daily.Sunrise = sunrise
 	}
-	if len(rawTime.Sunset) > 0 {
-		sunset, err := parseDateTimeArray(rawTime.Sunset, loc)
+	return result
+}
+
+// splitModelBlock splits a time series block by model (see splitModelFields)
+// and re-encodes the fields of each model as a separate JSON object, so that
+// it can be decoded like a single-model block.
+func splitModelBlock(data json.RawMessage, models []string) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[string]json.RawMessage, len(models))
+	for model, modelFields := range splitModelFields(fields, models) {
+		block, err := json.Marshal(modelFields)
 		if err != nil {
 			return nil, err
 		}
-		daily.Sunset = sunset
+		blocks[model] = block
 	}
-
-	return daily, nil
+	return blocks, nil
 }
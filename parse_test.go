@@ -1,7 +1,9 @@
 package omgo
 
 import (
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,8 +36,12 @@ func TestParseHourlyForecast(t *testing.T) {
 
 	// Check values
 	assert.Equal(t, 2.5, weather.Hourly.Temperature2m[0])
-	assert.Equal(t, WeatherCode(3), weather.Hourly.WeatherCode[0])
-	assert.Equal(t, WeatherCode(61), weather.Hourly.WeatherCode[1])
+	code, ok := weather.Hourly.WeatherCode.At(0)
+	assert.True(t, ok)
+	assert.Equal(t, Overcast, code)
+	code, ok = weather.Hourly.WeatherCode.At(1)
+	assert.True(t, ok)
+	assert.Equal(t, RainSlight, code)
 
 	// Check time parsing with timezone
 	loc, _ := time.LoadLocation("Europe/Berlin")
@@ -147,3 +153,201 @@ func TestWeatherCodeString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNullValues(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_nulls.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	// Hourly: real zero must stay distinguishable from a missing value
+	require.NotNil(t, weather.Hourly)
+	assert.Equal(t, 0.0, weather.Hourly.Temperature2m[0])
+	assert.False(t, weather.Hourly.Temperature2m.IsMissing(0))
+	assert.True(t, weather.Hourly.Temperature2m.IsMissing(2))
+	assert.Equal(t, 3, weather.Hourly.SoilTemperature54cm.Missing())
+
+	// 15-minutely
+	require.NotNil(t, weather.Minutely15)
+	assert.True(t, weather.Minutely15.Precipitation.IsMissing(0))
+	assert.False(t, weather.Minutely15.Precipitation.IsMissing(1))
+
+	// Daily
+	require.NotNil(t, weather.Daily)
+	assert.Equal(t, []float64{5.2}, weather.Daily.Temperature2mMax.Present())
+	assert.True(t, weather.Daily.Temperature2mMax.IsMissing(1))
+}
+
+func TestParseNullWeatherCodes(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_null_codes.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	// A missing code must not read as ClearSky, nor a missing is_day as night
+	require.NotNil(t, weather.Hourly)
+	code, ok := weather.Hourly.WeatherCode.At(0)
+	assert.True(t, ok)
+	assert.Equal(t, Overcast, code)
+	_, ok = weather.Hourly.WeatherCode.At(1)
+	assert.False(t, ok)
+	code, ok = weather.Hourly.WeatherCode.At(2)
+	assert.True(t, ok)
+	assert.Equal(t, ClearSky, code)
+	assert.True(t, weather.Hourly.IsDay.IsMissing(1))
+	assert.Equal(t, []float64{0, 1}, weather.Hourly.IsDay.Present())
+
+	s, ok := weather.Hourly.Series(HourlyWeatherCode)
+	require.True(t, ok)
+	assert.True(t, s.IsMissing(1))
+	s, ok = weather.Hourly.Series(HourlyIsDay)
+	require.True(t, ok)
+	assert.True(t, s.IsMissing(1))
+
+	require.NotNil(t, weather.Daily)
+	assert.True(t, weather.Daily.WeatherCode.IsMissing(0))
+	assert.True(t, weather.Daily.WeatherCode.HasMissing())
+
+	// Exports leave missing codes empty
+	var buf strings.Builder
+	require.NoError(t, weather.WriteCSV(&buf, BlockHourly))
+	assert.Equal(t, "time,weather_code (wmo code),is_day\n"+
+		"2024-01-15T00:00:00Z,3,0\n"+
+		"2024-01-15T01:00:00Z,,\n"+
+		"2024-01-15T02:00:00Z,0,1\n", buf.String())
+
+	// Missing codes are written as null
+	out, err := json.Marshal(weather.Hourly.WeatherCode)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3,null,0]`, string(out))
+}
+
+func TestParseExtraVariables(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_extra.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data)
+	require.NoError(t, err)
+
+	// Hourly: known variables keep their fields, unknown ones go to Extra
+	require.NotNil(t, weather.Hourly)
+	assert.Equal(t, Series{2.5, 2.3}, weather.Hourly.Temperature2m)
+	require.Len(t, weather.Hourly.Extra, 2)
+	assert.Equal(t, Series{450, 520}, weather.Hourly.Extra["boundary_layer_height"])
+	assert.True(t, weather.Hourly.Extra["convective_inhibition"].IsMissing(1))
+	assert.Equal(t, "m", weather.HourlyUnits.Extra["boundary_layer_height"])
+	assert.NotContains(t, weather.HourlyUnits.Extra, "time")
+
+	s, ok := weather.Hourly.Series(HourlyMetric("boundary_layer_height"))
+	require.True(t, ok)
+	assert.Equal(t, Series{450, 520}, s)
+	assert.Equal(t, "J/kg", weather.HourlyUnits.For(HourlyMetric("convective_inhibition")))
+
+	// 15-minutely
+	require.NotNil(t, weather.Minutely15)
+	assert.Equal(t, Series{15, 0}, weather.Minutely15.Extra["sunshine_minutes"])
+	assert.Equal(t, "min", weather.Minutely15Units.Extra["sunshine_minutes"])
+
+	// Daily: sun times are parsed into their fields, not Extra
+	require.NotNil(t, weather.Daily)
+	assert.Len(t, weather.Daily.Sunrise, 1)
+	assert.Equal(t, map[string]Series{"cape_max": {150}}, weather.Daily.Extra)
+	assert.Equal(t, map[string]string{"cape_max": "J/kg"}, weather.DailyUnits.Extra)
+
+	// Current
+	require.NotNil(t, weather.Current)
+	assert.Equal(t, map[string]float64{"convective_inhibition": 12}, weather.Current.Extra)
+	v, ok := weather.Current.Value(CurrentMetric("convective_inhibition"))
+	require.True(t, ok)
+	assert.Equal(t, 12.0, v)
+	assert.Equal(t, "J/kg", weather.CurrentUnits.For(CurrentMetric("convective_inhibition")))
+
+	// Responses without unknown variables have no Extra maps
+	known, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+	weather, err = parseWeatherResponse(known)
+	require.NoError(t, err)
+	assert.Nil(t, weather.Hourly.Extra)
+	assert.Nil(t, weather.HourlyUnits.Extra)
+}
+
+func TestParseModels(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_models.json")
+	require.NoError(t, err)
+
+	weather, err := parseWeatherResponse(data, ModelECMWFIFS025, ModelICONSeamless)
+	require.NoError(t, err)
+	require.Len(t, weather.Models, 2)
+
+	ecmwf := weather.ForModel(ModelECMWFIFS025)
+	require.NotNil(t, ecmwf)
+	require.NotNil(t, ecmwf.Hourly)
+	assert.Len(t, ecmwf.Hourly.Times, 3)
+	assert.Equal(t, time.Date(2024, 1, 15, 1, 0, 0, 0, time.UTC), ecmwf.Hourly.Times[1].UTC())
+	assert.Equal(t, 1.8, ecmwf.Hourly.Temperature2m[1])
+	assert.Equal(t, 0.1, ecmwf.Hourly.Precipitation[1])
+	require.NotNil(t, ecmwf.HourlyUnits)
+	assert.Equal(t, "°C", ecmwf.HourlyUnits.Temperature2m)
+	require.NotNil(t, ecmwf.Daily)
+	assert.Equal(t, 4.2, ecmwf.Daily.Temperature2mMax[0])
+	require.NotNil(t, ecmwf.DailyUnits)
+	assert.Equal(t, "°C", ecmwf.DailyUnits.Temperature2mMax)
+	assert.Nil(t, ecmwf.Current)
+
+	icon := weather.ForModel(ModelICONSeamless)
+	require.NotNil(t, icon)
+	require.NotNil(t, icon.Hourly)
+	assert.Equal(t, 2.5, icon.Hourly.Temperature2m[0])
+	assert.True(t, icon.Hourly.Temperature2m.IsMissing(2))
+	assert.Equal(t, 0.2, icon.Hourly.Precipitation[2])
+	assert.Equal(t, 4.9, icon.Daily.Temperature2mMax[0])
+
+	// Suffixed variables are not mixed into the combined data
+	assert.Empty(t, weather.Hourly.Temperature2m)
+	assert.Empty(t, weather.Hourly.Extra)
+	assert.Empty(t, weather.HourlyUnits.Extra)
+	assert.Empty(t, weather.Daily.Extra)
+	assert.Empty(t, weather.DailyUnits.Extra)
+	assert.Nil(t, weather.ForModel(ModelGFSSeamless))
+
+	// A single model maps to the combined data
+	single, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+	weather, err = parseWeatherResponse(single, ModelICONSeamless)
+	require.NoError(t, err)
+	require.NotNil(t, weather.ForModel(ModelICONSeamless))
+	assert.Same(t, weather.Hourly, weather.ForModel(ModelICONSeamless).Hourly)
+
+	// No models requested
+	weather, err = parseWeatherResponse(single)
+	require.NoError(t, err)
+	assert.Nil(t, weather.Models)
+	assert.Nil(t, weather.ForModel(ModelICONSeamless))
+}
+
+func TestParseMultiLocation(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_multi.json")
+	require.NoError(t, err)
+
+	weathers, err := parseMultiWeatherResponse(data, 2)
+	require.NoError(t, err)
+	require.Len(t, weathers, 2)
+
+	assert.InDelta(t, 52.52, weathers[0].Latitude, 0.01)
+	assert.Equal(t, 2.5, weathers[0].Hourly.Temperature2m[0])
+	assert.InDelta(t, 48.84, weathers[1].Latitude, 0.01)
+	assert.Equal(t, 6.1, weathers[1].Hourly.Temperature2m[0])
+
+	// Count mismatch
+	_, err = parseMultiWeatherResponse(data, 3)
+	assert.Error(t, err)
+
+	// Single object response
+	single, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+	weathers, err = parseMultiWeatherResponse(single, 1)
+	require.NoError(t, err)
+	require.Len(t, weathers, 1)
+}
@@ -0,0 +1,362 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// MaxPreviousDays is the largest lead time in days offered by the Previous Runs API.
+const MaxPreviousDays = 7
+
+// PreviousRunsRequest represents a request to the Previous Runs API.
+type PreviousRunsRequest struct {
+	location Location
+
+	// Metrics to request
+	hourlyMetrics []HourlyMetric
+	previousDays  []int
+
+	// Units
+	temperatureUnit   TemperatureUnit
+	windSpeedUnit     WindSpeedUnit
+	precipitationUnit PrecipitationUnit
+
+	// Time options
+	timezone     string
+	forecastDays int
+	pastDays     int
+
+	// Date range options
+	startDate string
+	endDate   string
+
+	// Other options
+	cellSelection CellSelection
+	models        []Model
+}
+
+// NewPreviousRunsRequest creates a new PreviousRunsRequest for the given coordinates.
+func NewPreviousRunsRequest(lat, lon float64) (*PreviousRunsRequest, error) {
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &PreviousRunsRequest{
+		location: loc,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *PreviousRunsRequest) WithLocation(loc Location) *PreviousRunsRequest {
+	r.location = loc
+	return r
+}
+
+// WithHourly adds hourly metrics to the request.
+func (r *PreviousRunsRequest) WithHourly(metrics ...HourlyMetric) *PreviousRunsRequest {
+	r.hourlyMetrics = append(r.hourlyMetrics, metrics...)
+	return r
+}
+
+// WithPreviousDays adds lead times (1-MaxPreviousDays) to request for every
+// hourly metric. Day n returns the value forecast by the model run n days
+// earlier. The latest run (day 0) is always included. Days already added
+// are ignored; days out of range make PreviousRuns return an error.
+func (r *PreviousRunsRequest) WithPreviousDays(days ...int) *PreviousRunsRequest {
+	for _, day := range days {
+		if !slices.Contains(r.previousDays, day) {
+			r.previousDays = append(r.previousDays, day)
+		}
+	}
+	return r
+}
+
+// WithTemperatureUnit sets the temperature unit.
+func (r *PreviousRunsRequest) WithTemperatureUnit(unit TemperatureUnit) *PreviousRunsRequest {
+	r.temperatureUnit = unit
+	return r
+}
+
+// WithWindSpeedUnit sets the wind speed unit.
+func (r *PreviousRunsRequest) WithWindSpeedUnit(unit WindSpeedUnit) *PreviousRunsRequest {
+	r.windSpeedUnit = unit
+	return r
+}
+
+// WithPrecipitationUnit sets the precipitation unit.
+func (r *PreviousRunsRequest) WithPrecipitationUnit(unit PrecipitationUnit) *PreviousRunsRequest {
+	r.precipitationUnit = unit
+	return r
+}
+
+// WithTimezone sets the timezone for the response.
+// Use "auto" to automatically detect timezone from coordinates.
+func (r *PreviousRunsRequest) WithTimezone(tz string) *PreviousRunsRequest {
+	r.timezone = tz
+	return r
+}
+
+// WithForecastDays sets the number of forecast days (0-16).
+func (r *PreviousRunsRequest) WithForecastDays(days int) *PreviousRunsRequest {
+	r.forecastDays = days
+	return r
+}
+
+// WithPastDays sets the number of past days to include.
+func (r *PreviousRunsRequest) WithPastDays(days int) *PreviousRunsRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *PreviousRunsRequest) WithDateRange(startDate, endDate string) *PreviousRunsRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+func (r *PreviousRunsRequest) WithCellSelection(selection CellSelection) *PreviousRunsRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// WithModels sets specific weather models to use. With several models the
+// data of each model is returned in PreviousRuns.Models.
+func (r *PreviousRunsRequest) WithModels(models ...Model) *PreviousRunsRequest {
+	r.models = append(r.models, models...)
+	return r
+}
+
+// validate checks that the requested lead times are offered by the API.
+func (r *PreviousRunsRequest) validate() error {
+	for _, day := range r.previousDays {
+		if day < 1 || day > MaxPreviousDays {
+			return fmt.Errorf("previous day must be between 1 and %d, got %d", MaxPreviousDays, day)
+		}
+	}
+	return nil
+}
+
+// hourlyVariables expands the requested metrics with a "_previous_dayN"
+// variable for every requested lead time.
+func (r *PreviousRunsRequest) hourlyVariables() []string {
+	vars := make([]string, 0, len(r.hourlyMetrics)*(len(r.previousDays)+1))
+	for _, m := range r.hourlyMetrics {
+		vars = append(vars, string(m))
+		for _, day := range r.previousDays {
+			vars = append(vars, string(m)+previousDaySuffix+strconv.Itoa(day))
+		}
+	}
+	return vars
+}
+
+// buildURL builds the URL for a previous runs request.
+func (r *PreviousRunsRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Metrics
+	if len(r.hourlyMetrics) > 0 {
+		params.Set("hourly", joinMetrics(r.hourlyVariables()))
+	}
+
+	// Units
+	if r.temperatureUnit != "" {
+		params.Set("temperature_unit", string(r.temperatureUnit))
+	}
+	if r.windSpeedUnit != "" {
+		params.Set("wind_speed_unit", string(r.windSpeedUnit))
+	}
+	if r.precipitationUnit != "" {
+		params.Set("precipitation_unit", string(r.precipitationUnit))
+	}
+
+	// Time options
+	if r.timezone != "" {
+		params.Set("timezone", r.timezone)
+	}
+	if r.forecastDays > 0 {
+		params.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+
+	// Date range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+
+	// Other options
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+	if len(r.models) > 0 {
+		params.Set("models", joinMetrics(r.models))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// LeadTimeSeries holds a variable as forecast by successive model runs.
+// Index 0 is the latest run and index n is the run from n days earlier,
+// i.e. with n*24 hours of additional lead time. Lead times that were not
+// requested are nil.
+type LeadTimeSeries []Series
+
+// Day returns the series forecast n days earlier, or nil if it was not returned.
+func (l LeadTimeSeries) Day(n int) Series {
+	if n < 0 || n >= len(l) {
+		return nil
+	}
+	return l[n]
+}
+
+// PreviousRuns contains the response from the Previous Runs API.
+type PreviousRuns struct {
+	// Location information
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// Hourly data
+	Hourly *PreviousRunsHourlyData `json:"-"` // parsed separately
+
+	// Models contains the hourly data of each model requested with
+	// WithModels. When several models are requested the data is only found
+	// here, and Hourly only holds the shared timestamps.
+	Models map[Model]*PreviousRunsHourlyData `json:"-"` // parsed separately
+}
+
+// PreviousRunsHourlyData contains hourly data from previous model runs.
+// Missing data points are stored as NaN; see Series.
+type PreviousRunsHourlyData struct {
+	// Time contains timestamps for each data point.
+	Times []time.Time
+
+	// Variables contains the lead times of each returned variable.
+	Variables map[HourlyMetric]LeadTimeSeries
+
+	// Units contains the unit string of each returned variable.
+	Units map[HourlyMetric]string
+}
+
+// LeadTimes returns the lead times of a variable, or nil if the variable
+// was not returned.
+func (d *PreviousRunsHourlyData) LeadTimes(metric HourlyMetric) LeadTimeSeries {
+	if d == nil {
+		return nil
+	}
+	return d.Variables[metric]
+}
+
+// rawPreviousRunsResponse represents the raw JSON response from the Previous Runs API.
+type rawPreviousRunsResponse struct {
+	rawMeta
+
+	Hourly      map[string]json.RawMessage `json:"hourly,omitempty"`
+	HourlyUnits map[string]string          `json:"hourly_units,omitempty"`
+}
+
+// parsePreviousRunsResponse parses the Previous Runs API response into a
+// PreviousRuns struct. Models are needed to split variables when several
+// were requested.
+func parsePreviousRunsResponse(body []byte, models []string) (*PreviousRuns, error) {
+	var raw rawPreviousRunsResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	runs := &PreviousRuns{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Elevation:            raw.Elevation,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+	}
+
+	if len(raw.Hourly) == 0 {
+		return runs, nil
+	}
+
+	times, err := parseFieldTimes(raw.Hourly, raw.timeLocation(), parseDateTimeArray)
+	if err != nil {
+		return nil, &DecodeError{Block: "hourly", Err: err}
+	}
+
+	if len(models) <= 1 {
+		variables, units, err := parseIndexedBlock[HourlyMetric, LeadTimeSeries](raw.Hourly, raw.HourlyUnits, previousDaySuffix, MaxPreviousDays)
+		if err != nil {
+			return nil, &DecodeError{Block: "hourly", Err: err}
+		}
+		runs.Hourly = &PreviousRunsHourlyData{
+			Times:     times,
+			Variables: variables,
+			Units:     units,
+		}
+		if len(models) == 1 {
+			runs.Models = map[Model]*PreviousRunsHourlyData{Model(models[0]): runs.Hourly}
+		}
+		return runs, nil
+	}
+
+	// Every variable is suffixed with its model
+	runs.Hourly = &PreviousRunsHourlyData{Times: times}
+	runs.Models = make(map[Model]*PreviousRunsHourlyData, len(models))
+	units := splitModelFields(raw.HourlyUnits, models)
+	for model, fields := range splitModelFields(raw.Hourly, models) {
+		variables, variableUnits, err := parseIndexedBlock[HourlyMetric, LeadTimeSeries](fields, units[model], previousDaySuffix, MaxPreviousDays)
+		if err != nil {
+			return nil, &DecodeError{Block: "hourly", Model: Model(model), Err: err}
+		}
+		runs.Models[Model(model)] = &PreviousRunsHourlyData{
+			Times:     times,
+			Variables: variables,
+			Units:     variableUnits,
+		}
+	}
+
+	return runs, nil
+}
+
+// PreviousRuns retrieves data from previous model runs for the given request.
+func (c *Client) PreviousRuns(ctx context.Context, req *PreviousRunsRequest) (*PreviousRuns, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	url := req.buildURL(c.previousRunsURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePreviousRunsResponse(body, sortedMetrics(req.models))
+}
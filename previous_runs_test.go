@@ -0,0 +1,122 @@
+package omgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviousRunsRequestURL(t *testing.T) {
+	req, err := NewPreviousRunsRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	req.WithHourly(HourlyTemperature2m).
+		WithPreviousDays(1, 3).
+		WithPreviousDays(3).
+		WithModels(ModelECMWFIFS025).
+		WithPastDays(7)
+
+	parsed, err := url.Parse(req.buildURL(previousRunsBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "temperature_2m,temperature_2m_previous_day1,temperature_2m_previous_day3", params.Get("hourly"))
+	assert.Equal(t, "ecmwf_ifs025", params.Get("models"))
+	assert.Equal(t, "7", params.Get("past_days"))
+}
+
+func TestParsePreviousRuns(t *testing.T) {
+	data, err := os.ReadFile("testdata/previous_runs.json")
+	require.NoError(t, err)
+
+	runs, err := parsePreviousRunsResponse(data, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, runs.Hourly)
+	require.Len(t, runs.Hourly.Times, 2)
+	assert.Equal(t, time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC), runs.Hourly.Times[1].UTC())
+
+	temp := runs.Hourly.LeadTimes(HourlyTemperature2m)
+	require.Len(t, temp, 4)
+	assert.Equal(t, Series{4.1, 3.8}, temp.Day(0))
+	assert.Equal(t, Series{3.6, 3.5}, temp.Day(1))
+	assert.Nil(t, temp.Day(2), "lead time not requested")
+	assert.True(t, temp.Day(3).IsMissing(1))
+	assert.Nil(t, temp.Day(7), "out of range")
+	assert.Equal(t, "°C", runs.Hourly.Units[HourlyTemperature2m])
+
+	// Lead times beyond MaxPreviousDays are rejected
+	_, err = parsePreviousRunsResponse([]byte(`{"hourly":{"time":["2024-03-01T00:00"],"temperature_2m_previous_day8":[1.0]}}`), nil)
+	assert.ErrorIs(t, err, ErrDecode)
+}
+
+func TestParsePreviousRunsModels(t *testing.T) {
+	data, err := os.ReadFile("testdata/previous_runs_models.json")
+	require.NoError(t, err)
+
+	runs, err := parsePreviousRunsResponse(data, []string{"ecmwf_ifs025", "gfs_seamless"})
+	require.NoError(t, err)
+
+	require.NotNil(t, runs.Hourly)
+	assert.Len(t, runs.Hourly.Times, 2)
+	assert.Nil(t, runs.Hourly.LeadTimes(HourlyTemperature2m))
+	require.Len(t, runs.Models, 2)
+
+	gfs := runs.Models[ModelGFSSeamless].LeadTimes(HourlyTemperature2m)
+	require.Len(t, gfs, 2)
+	assert.Equal(t, Series{4.4, 4.0}, gfs.Day(0))
+	assert.True(t, gfs.Day(1).IsMissing(1))
+	assert.Equal(t, "°C", runs.Models[ModelGFSSeamless].Units[HourlyTemperature2m])
+
+	ecmwf := runs.Models[ModelECMWFIFS025].LeadTimes(HourlyTemperature2m)
+	require.Len(t, ecmwf, 2)
+	assert.Equal(t, Series{3.6, 3.5}, ecmwf.Day(1))
+	assert.Len(t, runs.Models[ModelECMWFIFS025].Times, 2)
+}
+
+func TestClientPreviousRuns(t *testing.T) {
+	data, err := os.ReadFile("testdata/previous_runs.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{response: newMockResponse(http.StatusOK, data)}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewPreviousRunsRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m).WithPreviousDays(1, 3)
+
+	runs, err := client.PreviousRuns(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, runs.Hourly.LeadTimes(HourlyTemperature2m), 4)
+	assert.Nil(t, runs.Hourly.LeadTimes(HourlyPrecipitation))
+	assert.Nil(t, runs.Models)
+
+	// A single model is returned without suffix
+	mock.response = newMockResponse(http.StatusOK, data)
+	req.WithModels(ModelECMWFIFS025)
+	runs, err = client.PreviousRuns(context.Background(), req)
+	require.NoError(t, err)
+	assert.Same(t, runs.Hourly, runs.Models[ModelECMWFIFS025])
+}
+
+func TestClientPreviousRunsInvalidDays(t *testing.T) {
+	for _, day := range []int{0, -1, MaxPreviousDays + 1} {
+		mock := &mockHTTPClient{err: errors.New("unexpected request")}
+		client := NewClient(WithHTTPClient(mock))
+
+		req, err := NewPreviousRunsRequest(52.52, 13.41)
+		require.NoError(t, err)
+		req.WithHourly(HourlyTemperature2m).WithPreviousDays(1, day)
+
+		_, err = client.PreviousRuns(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "previous day must be between 1 and 7")
+	}
+}
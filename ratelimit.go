@@ -0,0 +1,258 @@
+package omgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExceeded is returned when a request would exceed the budget of a
+// RateLimiter that fails fast, or costs more than a window allows at all.
+var ErrQuotaExceeded = errors.New("client-side quota exceeded")
+
+// RateLimit is a budget of weighted API calls per sliding window; see
+// RequestCost. Zero limits are unlimited.
+type RateLimit struct {
+	PerMinute float64
+	PerHour   float64
+	PerDay    float64
+
+	// FailFast makes requests over budget fail with ErrQuotaExceeded
+	// instead of waiting until the budget allows them.
+	FailFast bool
+}
+
+// FreeTierRateLimit returns the limits of the free, non-commercial API:
+// 600 calls per minute, 5000 per hour and 10000 per day.
+func FreeTierRateLimit() RateLimit {
+	return RateLimit{PerMinute: 600, PerHour: 5000, PerDay: 10000}
+}
+
+// Usage is a snapshot of the calls counted by a RateLimiter.
+type Usage struct {
+	LastMinute float64 // weighted calls in the last minute
+	LastHour   float64 // weighted calls in the last hour
+	LastDay    float64 // weighted calls in the last 24 hours
+	Total      float64 // weighted calls since the limiter was created
+	Requests   int     // requests sent since the limiter was created
+}
+
+// rateEvent is a request counted by a RateLimiter.
+type rateEvent struct {
+	at   time.Time
+	cost float64
+}
+
+// RateLimiter keeps requests within a RateLimit and accounts their usage.
+// It is safe for concurrent use and can be shared by several clients to
+// enforce a common quota.
+type RateLimiter struct {
+	limit RateLimit
+	now   func() time.Time
+
+	mu       sync.Mutex
+	events   []rateEvent // within the last day, oldest first
+	total    float64
+	requests int
+}
+
+// NewRateLimiter creates a RateLimiter with the given limits.
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	return &RateLimiter{limit: limit, now: time.Now}
+}
+
+// WithRateLimiter limits the requests of the client with l. Each request,
+// including retries, is counted with its weight from RequestCost.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// Usage returns the current usage counters.
+func (l *RateLimiter) Usage() Usage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.expire(now)
+	return Usage{
+		LastMinute: l.used(now, time.Minute),
+		LastHour:   l.used(now, time.Hour),
+		LastDay:    l.used(now, 24*time.Hour),
+		Total:      l.total,
+		Requests:   l.requests,
+	}
+}
+
+// Wait counts a request of the given cost, waiting until the budget allows
+// it unless the limit fails fast. It returns ErrQuotaExceeded if the request
+// is not allowed, or the context's error if ctx is done while waiting.
+func (l *RateLimiter) Wait(ctx context.Context, cost float64) error {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		l.expire(now)
+		wait, err := l.reserve(now, cost)
+		l.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve counts the request if every window allows it, or returns how long
+// to wait before trying again.
+func (l *RateLimiter) reserve(now time.Time, cost float64) (time.Duration, error) {
+	var wait time.Duration
+	for _, w := range []struct {
+		name   string
+		window time.Duration
+		limit  float64
+	}{
+		{"minute", time.Minute, l.limit.PerMinute},
+		{"hour", time.Hour, l.limit.PerHour},
+		{"day", 24 * time.Hour, l.limit.PerDay},
+	} {
+		if w.limit <= 0 {
+			continue
+		}
+		if cost > w.limit {
+			return 0, fmt.Errorf("%w: request costs %.1f calls, limit is %.0f per %s", ErrQuotaExceeded, cost, w.limit, w.name)
+		}
+		used := l.used(now, w.window)
+		if used+cost <= w.limit {
+			continue
+		}
+		if l.limit.FailFast {
+			return 0, fmt.Errorf("%w: %.1f of %.0f calls per %s used, request costs %.1f", ErrQuotaExceeded, used, w.limit, w.name, cost)
+		}
+		// Wait until enough of the oldest calls in the window have expired
+		for _, e := range l.events {
+			if now.Sub(e.at) >= w.window {
+				continue
+			}
+			used -= e.cost
+			if used+cost <= w.limit {
+				wait = max(wait, e.at.Add(w.window).Sub(now))
+				break
+			}
+		}
+	}
+	if wait > 0 {
+		return wait, nil
+	}
+
+	l.events = append(l.events, rateEvent{at: now, cost: cost})
+	l.total += cost
+	l.requests++
+	return 0, nil
+}
+
+// used returns the cost of the calls within the window before now.
+func (l *RateLimiter) used(now time.Time, window time.Duration) float64 {
+	var used float64
+	for i := len(l.events) - 1; i >= 0 && now.Sub(l.events[i].at) < window; i-- {
+		used += l.events[i].cost
+	}
+	return used
+}
+
+// expire drops calls older than the longest window.
+func (l *RateLimiter) expire(now time.Time) {
+	i := 0
+	for i < len(l.events) && now.Sub(l.events[i].at) >= 24*time.Hour {
+		i++
+	}
+	l.events = l.events[i:]
+}
+
+// RequestCost returns the weight the API counts for a request URL. Requests
+// with more than 10 variables or more than 2 weeks of data count as several
+// calls: the cost per location is max(1, variables/10) * max(1, days/14),
+// where variables are multiplied by the number of models. Requests without
+// time series, such as geocoding, cost 1.
+func RequestCost(rawURL string) float64 {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 1
+	}
+	q := u.Query()
+
+	variables := 0
+	for _, key := range []string{"hourly", "daily", "current", "minutely_15", "six_hourly"} {
+		variables += countList(q.Get(key))
+	}
+	variables *= max(1, countList(q.Get("models")))
+	locations := max(1, countList(q.Get("latitude")))
+
+	return float64(locations) * math.Max(1, float64(variables)/10) * math.Max(1, requestDays(q)/14)
+}
+
+// requestDays returns the number of days of data requested. Hour-based
+// ranges, forecast_hours and past_hours, take precedence over their day-based
+// counterparts.
+func requestDays(q url.Values) float64 {
+	if start, end := q.Get("start_date"), q.Get("end_date"); start != "" && end != "" {
+		s, err1 := time.Parse(timeLayoutDate, start)
+		e, err2 := time.Parse(timeLayoutDate, end)
+		if err1 == nil && err2 == nil && !e.Before(s) {
+			return e.Sub(s).Hours()/24 + 1
+		}
+	}
+	if start, end := q.Get("start_hour"), q.Get("end_hour"); start != "" && end != "" {
+		s, err1 := time.Parse(timeLayoutDateTime, start)
+		e, err2 := time.Parse(timeLayoutDateTime, end)
+		if err1 == nil && err2 == nil && !e.Before(s) {
+			return e.Sub(s).Hours() / 24
+		}
+	}
+	forecast := 7.0 // API default
+	if v, err := strconv.Atoi(q.Get("forecast_hours")); err == nil {
+		forecast = float64(v) / 24
+	} else if v, err := strconv.Atoi(q.Get("forecast_days")); err == nil {
+		forecast = float64(v)
+	}
+	past := 0.0
+	if v, err := strconv.Atoi(q.Get("past_hours")); err == nil {
+		past = float64(v) / 24
+	} else if v, err := strconv.Atoi(q.Get("past_days")); err == nil {
+		past = float64(v)
+	}
+	return forecast + past
+}
+
+// countList returns the number of items of a comma-separated parameter.
+func countList(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, ",") + 1
+}
+
+// Cost returns the weight the API counts for the request; see RequestCost.
+func (r *ForecastRequest) Cost() float64 {
+	return RequestCost(r.buildURL("", ""))
+}
+
+// Cost returns the weight the API counts for the request; see RequestCost.
+func (r *HistoricalRequest) Cost() float64 {
+	return RequestCost(r.buildURL("", ""))
+}
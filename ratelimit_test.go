@@ -0,0 +1,145 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testClock is a manually advanced clock for rate limiter tests.
+type testClock struct{ t time.Time }
+
+func (c *testClock) now() time.Time { return c.t }
+
+func newTestRateLimiter(limit RateLimit) (*RateLimiter, *testClock) {
+	clock := &testClock{t: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)}
+	l := NewRateLimiter(limit)
+	l.now = clock.now
+	return l, clock
+}
+
+func TestRequestCost(t *testing.T) {
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m, HourlyPrecipitation)
+	assert.Equal(t, 1.0, req.Cost())
+
+	// 15 variables count as 1.5 calls
+	req.WithDaily(DailyTemperature2mMax, DailyTemperature2mMin, DailyPrecipitationSum, DailyRainSum, DailySnowfallSum,
+		DailySunrise, DailySunset, DailyWeatherCode, DailyWindSpeed10mMax, DailyWindGusts10mMax, DailyUVIndexMax, DailyShowersSum, DailyPrecipitationHours)
+	assert.Equal(t, 1.5, req.Cost())
+
+	// Two models double the variables, 28 days double the weight
+	req.WithModels(ModelECMWFIFS025, ModelICONSeamless).WithForecastDays(16).WithPastDays(12)
+	assert.Equal(t, 6.0, req.Cost())
+
+	// A year of history for two locations
+	hist, err := NewHistoricalRequest(52.52, 13.41, "2023-01-01", "2023-12-31")
+	require.NoError(t, err)
+	hist.WithHourly(HourlyTemperature2m)
+	assert.InDelta(t, 365.0/14, hist.Cost(), 1e-9)
+
+	multi, err := NewForecastRequestForLocations(Location{Latitude: 52.52, Longitude: 13.41}, Location{Latitude: 48.85, Longitude: 2.35})
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, multi.WithHourly(HourlyTemperature2m).Cost())
+
+	// Hour-based ranges replace the day-based defaults
+	hourly, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	hourly.WithHourly(HourlyTemperature2m).WithForecastDays(16).WithForecastHours(48).WithPastHours(24)
+	assert.Equal(t, 1.0, hourly.Cost())
+	assert.Equal(t, 2.0, RequestCost("https://api.open-meteo.com/v1/forecast?hourly=temperature_2m&forecast_hours=672"))
+	assert.Equal(t, 3.0, requestDays(url.Values{"forecast_hours": {"48"}, "past_hours": {"24"}}))
+	assert.Equal(t, 21.0, requestDays(url.Values{"forecast_hours": {"168"}, "past_days": {"14"}}))
+	assert.Equal(t, 0.5, requestDays(url.Values{"start_hour": {"2024-01-01T00:00"}, "end_hour": {"2024-01-01T12:00"}}))
+
+	// Endpoints without time series
+	assert.Equal(t, 1.0, RequestCost("https://geocoding-api.open-meteo.com/v1/search?name=Berlin"))
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	l, clock := newTestRateLimiter(RateLimit{PerMinute: 3, PerHour: 5, FailFast: true})
+	ctx := context.Background()
+
+	require.NoError(t, l.Wait(ctx, 1))
+	require.NoError(t, l.Wait(ctx, 2))
+	assert.ErrorIs(t, l.Wait(ctx, 1), ErrQuotaExceeded)
+
+	// The minute window frees up, the hour window doesn't
+	clock.t = clock.t.Add(time.Minute)
+	require.NoError(t, l.Wait(ctx, 2))
+	err := l.Wait(ctx, 1)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Contains(t, err.Error(), "per hour")
+
+	// A request over a window's limit never fits
+	assert.ErrorIs(t, l.Wait(ctx, 10), ErrQuotaExceeded)
+
+	usage := l.Usage()
+	assert.Equal(t, 2.0, usage.LastMinute)
+	assert.Equal(t, 5.0, usage.LastHour)
+	assert.Equal(t, 5.0, usage.LastDay)
+	assert.Equal(t, 5.0, usage.Total)
+	assert.Equal(t, 3, usage.Requests)
+
+	// Calls expire from the day window, but stay in the total
+	clock.t = clock.t.Add(24 * time.Hour)
+	usage = l.Usage()
+	assert.Equal(t, 0.0, usage.LastDay)
+	assert.Equal(t, 5.0, usage.Total)
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l, clock := newTestRateLimiter(RateLimit{PerMinute: 2})
+
+	require.NoError(t, l.Wait(context.Background(), 1))
+	clock.t = clock.t.Add(30 * time.Second)
+	require.NoError(t, l.Wait(context.Background(), 1))
+
+	l.mu.Lock()
+	wait, err := l.reserve(clock.t, 1)
+	l.mu.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, wait)
+
+	// Waiting honours the context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx, 1), context.DeadlineExceeded)
+	assert.Equal(t, 2, l.Usage().Requests)
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	requests := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return newMockResponse(http.StatusOK, data), nil
+	})
+
+	limiter := NewRateLimiter(RateLimit{PerDay: 2, FailFast: true})
+	client := NewClient(WithHTTPClient(mock), WithRateLimiter(limiter), WithRetry(testRetryPolicy()))
+
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	_, err = client.Forecast(context.Background(), req)
+	require.NoError(t, err)
+	_, err = client.Forecast(context.Background(), req)
+	require.NoError(t, err)
+
+	// Over budget: not sent and not retried
+	_, err = client.Forecast(context.Background(), req)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2.0, limiter.Usage().LastDay)
+}
@@ -1,10 +1,13 @@
 package omgo
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 // ForecastRequest represents a request to the Forecast API.
 type ForecastRequest struct {
-	location Location
+	locations []Location
 
 	// Metrics to request
 	hourlyMetrics     []HourlyMetric
@@ -32,7 +35,8 @@ type ForecastRequest struct {
 
 	// Other options
 	cellSelection CellSelection
-	models        []string
+	models        []Model
+	format        ResponseFormat
 
 	// Solar radiation options (for global_tilted_irradiance)
 	tilt    *float64
@@ -46,13 +50,44 @@ func NewForecastRequest(lat, lon float64) (*ForecastRequest, error) {
 		return nil, err
 	}
 	return &ForecastRequest{
-		location: loc,
+		locations: []Location{loc},
 	}, nil
 }
 
+// NewForecastRequestForLocations creates a new ForecastRequest covering several locations.
+// The API is queried once for all locations; use Client.ForecastMulti to retrieve
+// one Weather per location, in the same order as locs.
+func NewForecastRequestForLocations(locs ...Location) (*ForecastRequest, error) {
+	if err := validateLocations(locs); err != nil {
+		return nil, err
+	}
+	return &ForecastRequest{
+		locations: slices.Clone(locs),
+	}, nil
+}
+
+// NewHistoricalForecastRequest creates a ForecastRequest for the Historical Forecast API,
+// which serves archived forecast model output rather than reanalysis data.
+// startDate and endDate should be in ISO8601 format (yyyy-mm-dd).
+// Use Client.HistoricalForecast to send the request.
+func NewHistoricalForecastRequest(lat, lon float64, startDate, endDate string) (*ForecastRequest, error) {
+	if startDate == "" {
+		return nil, fmt.Errorf("startDate is required for historical forecast requests")
+	}
+	if endDate == "" {
+		return nil, fmt.Errorf("endDate is required for historical forecast requests")
+	}
+	req, err := NewForecastRequest(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithDateRange(startDate, endDate), nil
+}
+
 // WithLocation sets the location from an existing Location struct.
+// This replaces any locations set previously.
 func (r *ForecastRequest) WithLocation(loc Location) *ForecastRequest {
-	r.location = loc
+	r.locations = []Location{loc}
 	return r
 }
 
@@ -153,11 +188,40 @@ func (r *ForecastRequest) WithCellSelection(selection CellSelection) *ForecastRe
 }
 
 // WithModels sets specific weather models to use.
-func (r *ForecastRequest) WithModels(models ...string) *ForecastRequest {
+// When several models are requested, each model's data is available via Weather.ForModel.
+func (r *ForecastRequest) WithModels(models ...Model) *ForecastRequest {
 	r.models = append(r.models, models...)
 	return r
 }
 
+// WithModelNames sets specific weather models by their API names, as
+// accepted by WithModels in v0.1.x.
+//
+// Deprecated: Use WithModels, converting names with Model(name) if needed.
+func (r *ForecastRequest) WithModelNames(names ...string) *ForecastRequest {
+	for _, name := range names {
+		r.models = append(r.models, Model(name))
+	}
+	return r
+}
+
+// WithFormat sets the response format. FormatFlatBuffers is decoded into the
+// same Weather structure as JSON, including the unit blocks.
+func (r *ForecastRequest) WithFormat(format ResponseFormat) *ForecastRequest {
+	r.format = format
+	return r
+}
+
+// variables returns the variables of each block in the order they are sent.
+func (r *ForecastRequest) variables() weatherVariables {
+	return weatherVariables{
+		current:    sortedMetrics(r.currentMetrics),
+		hourly:     sortedMetrics(r.hourlyMetrics),
+		minutely15: sortedMetrics(r.minutely15Metrics),
+		daily:      sortedMetrics(r.dailyMetrics),
+	}
+}
+
 // WithTilt sets the tilt angle for global_tilted_irradiance calculations (0-90 degrees).
 func (r *ForecastRequest) WithTilt(degrees float64) *ForecastRequest {
 	r.tilt = &degrees
@@ -173,7 +237,7 @@ func (r *ForecastRequest) WithAzimuth(degrees float64) *ForecastRequest {
 
 // HistoricalRequest represents a request to the Historical API.
 type HistoricalRequest struct {
-	location Location
+	locations []Location
 
 	// Required date range
 	startDate string
@@ -191,6 +255,7 @@ type HistoricalRequest struct {
 	// Other options
 	timezone      string
 	cellSelection CellSelection
+	format        ResponseFormat
 
 	// Solar radiation options
 	tilt    *float64
@@ -211,15 +276,35 @@ func NewHistoricalRequest(lat, lon float64, startDate, endDate string) (*Histori
 		return nil, fmt.Errorf("endDate is required for historical requests")
 	}
 	return &HistoricalRequest{
-		location:  loc,
+		locations: []Location{loc},
+		startDate: startDate,
+		endDate:   endDate,
+	}, nil
+}
+
+// NewHistoricalRequestForLocations creates a new HistoricalRequest covering several locations.
+// Use Client.HistoricalMulti to retrieve one Weather per location, in the same order as locs.
+func NewHistoricalRequestForLocations(locs []Location, startDate, endDate string) (*HistoricalRequest, error) {
+	if err := validateLocations(locs); err != nil {
+		return nil, err
+	}
+	if startDate == "" {
+		return nil, fmt.Errorf("startDate is required for historical requests")
+	}
+	if endDate == "" {
+		return nil, fmt.Errorf("endDate is required for historical requests")
+	}
+	return &HistoricalRequest{
+		locations: slices.Clone(locs),
 		startDate: startDate,
 		endDate:   endDate,
 	}, nil
 }
 
 // WithLocation sets the location from an existing Location struct.
+// This replaces any locations set previously.
 func (r *HistoricalRequest) WithLocation(loc Location) *HistoricalRequest {
-	r.location = loc
+	r.locations = []Location{loc}
 	return r
 }
 
@@ -265,6 +350,21 @@ func (r *HistoricalRequest) WithCellSelection(selection CellSelection) *Historic
 	return r
 }
 
+// WithFormat sets the response format. FormatFlatBuffers is decoded into the
+// same Weather structure as JSON, including the unit blocks.
+func (r *HistoricalRequest) WithFormat(format ResponseFormat) *HistoricalRequest {
+	r.format = format
+	return r
+}
+
+// variables returns the variables of each block in the order they are sent.
+func (r *HistoricalRequest) variables() weatherVariables {
+	return weatherVariables{
+		hourly: sortedMetrics(r.hourlyMetrics),
+		daily:  sortedMetrics(r.dailyMetrics),
+	}
+}
+
 // WithTilt sets the tilt angle for global_tilted_irradiance calculations (0-90 degrees).
 func (r *HistoricalRequest) WithTilt(degrees float64) *HistoricalRequest {
 	r.tilt = &degrees
@@ -0,0 +1,178 @@
+package omgo
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures retries of failed requests; see WithRetry.
+// Delays grow exponentially from BaseDelay with jitter, up to MaxDelay.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. It doubles with every
+	// further retry; each delay is randomized between half and all of it.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. A Retry-After header asking
+	// for a longer wait ends the retries. Zero means no cap.
+	MaxDelay time.Duration
+
+	// Retryable reports whether a failed attempt should be retried.
+	// If nil, IsRetryable is used.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy returns a policy of 3 attempts with delays starting at
+// 500ms and capped at 30s, retrying the errors accepted by IsRetryable.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// WithRetry enables retries of failed requests with the given policy.
+// By default, every request is attempted once.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = &policy
+	}
+}
+
+// IsRetryable reports whether err is likely transient: an APIError with
+// status 408, 429, 500, 502, 503 or 504, or a transport error such as a
+// network operation failure, a timeout, a connection reset or a response
+// cut short. Context cancellation, deadlines, ErrQuotaExceeded, certificate
+// errors and all other errors, such as a DecodeError, are not retryable.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrQuotaExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+			http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &certErr) {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// RetryError is returned when a request with a retry policy fails.
+// It wraps the error of the last attempt.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+// Error implements the error interface.
+func (e *RetryError) Error() string {
+	if e.Attempts == 1 {
+		return fmt.Sprintf("after 1 attempt: %v", e.Err)
+	}
+	return fmt.Sprintf("after %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// do calls attempt until it succeeds, fails with an error that is
+// not retryable, or the policy's attempts are used up.
+func (p *RetryPolicy) do(ctx context.Context, attempt func() ([]byte, error)) ([]byte, error) {
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	for n := 1; ; n++ {
+		body, err := attempt()
+		if err == nil {
+			return body, nil
+		}
+		if n >= p.MaxAttempts || !retryable(err) {
+			return nil, &RetryError{Attempts: n, Err: err}
+		}
+
+		delay := p.backoff(n)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > delay {
+			if p.MaxDelay > 0 && apiErr.RetryAfter > p.MaxDelay {
+				return nil, &RetryError{Attempts: n, Err: err}
+			}
+			delay = apiErr.RetryAfter
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, &RetryError{Attempts: n, Err: fmt.Errorf("%w; last error: %w", ctx.Err(), err)}
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the jittered delay after the given failed attempt.
+// Without MaxDelay the delay stops growing before it would overflow.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay) && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP
+// date. It returns 0 if the header is absent or invalid.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,197 @@
+package omgo
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRetryPolicy retries quickly so tests don't sleep.
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+}
+
+func TestClientRetry(t *testing.T) {
+	data, err := os.ReadFile("testdata/forecast_hourly.json")
+	require.NoError(t, err)
+
+	attempts := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		switch attempts {
+		case 1:
+			return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: syscall.ECONNRESET}
+		case 2:
+			return newMockResponse(http.StatusBadGateway, []byte("bad gateway")), nil
+		}
+		return newMockResponse(http.StatusOK, data), nil
+	})
+
+	client := NewClient(WithHTTPClient(mock), WithRetry(testRetryPolicy()))
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	weather, err := client.Forecast(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, weather.Hourly)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientRetryGivesUp(t *testing.T) {
+	attempts := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newMockResponse(http.StatusServiceUnavailable, []byte(`{"error": true, "reason": "Overloaded"}`)), nil
+	})
+
+	client := NewClient(WithHTTPClient(mock), WithRetry(testRetryPolicy()))
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	_, err = client.Forecast(context.Background(), req)
+	require.Error(t, err)
+	assert.Equal(t, 3, attempts)
+
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, 3, retryErr.Attempts)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "Overloaded", apiErr.Reason)
+	assert.Contains(t, err.Error(), "after 3 attempts")
+}
+
+func TestClientRetryNotRetryable(t *testing.T) {
+	attempts := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newMockResponse(http.StatusBadRequest, []byte(`{"error": true, "reason": "Invalid"}`)), nil
+	})
+
+	client := NewClient(WithHTTPClient(mock), WithRetry(testRetryPolicy()))
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	_, err = client.Forecast(context.Background(), req)
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, 1, retryErr.Attempts)
+	assert.Equal(t, 1, attempts)
+
+	// Custom predicate
+	attempts = 0
+	policy := testRetryPolicy()
+	policy.Retryable = func(err error) bool { return true }
+	client = NewClient(WithHTTPClient(mock), WithRetry(policy))
+	_, err = client.Forecast(context.Background(), req)
+	require.Error(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientRetryAfter(t *testing.T) {
+	attempts := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		resp := newMockResponse(http.StatusTooManyRequests, []byte(`{"error": true, "reason": "Too many requests"}`))
+		resp.Header = http.Header{"Retry-After": []string{"60"}}
+		return resp, nil
+	})
+
+	client := NewClient(WithHTTPClient(mock), WithRetry(testRetryPolicy()))
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	// Retry-After exceeds MaxDelay, so the request is not retried
+	_, err = client.Forecast(context.Background(), req)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, time.Minute, apiErr.RetryAfter)
+	assert.Equal(t, 1, attempts)
+
+	// Without a cap the wait is honoured until the context is done
+	attempts = 0
+	policy := testRetryPolicy()
+	policy.MaxDelay = 0
+	client = NewClient(WithHTTPClient(mock), WithRetry(policy))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.Forecast(ctx, req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(&APIError{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsRetryable(&APIError{StatusCode: http.StatusBadGateway}))
+	assert.False(t, IsRetryable(&APIError{StatusCode: http.StatusBadRequest}))
+	assert.True(t, IsRetryable(fmt.Errorf("executing request: %w", &url.Error{Op: "Get", Err: syscall.ECONNRESET})))
+	assert.True(t, IsRetryable(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}))
+	assert.True(t, IsRetryable(fmt.Errorf("reading response body: %w", io.ErrUnexpectedEOF)))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(ErrQuotaExceeded))
+	assert.False(t, IsRetryable(&DecodeError{Err: errors.New("unexpected end of JSON input")}))
+	assert.False(t, IsRetryable(&url.Error{Op: "Get", Err: &tls.CertificateVerificationError{Err: errors.New("unknown authority")}}))
+	assert.False(t, IsRetryable(errors.New("unsupported protocol scheme")))
+}
+
+func TestClientRetrySkipsDecodeErrors(t *testing.T) {
+	attempts := 0
+	mock := mockHTTPFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newMockResponse(http.StatusOK, []byte(`{"latitude": `)), nil
+	})
+	client := NewClient(WithHTTPClient(mock), WithRetry(testRetryPolicy()))
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	_, err = client.Forecast(context.Background(), req)
+	require.ErrorIs(t, err, ErrDecode)
+	assert.Equal(t, 1, attempts)
+
+	// The retry policy's own decision
+	policy := testRetryPolicy()
+	_, err = policy.do(context.Background(), func() ([]byte, error) {
+		return nil, &DecodeError{Err: errors.New("bad")}
+	})
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, 1, retryErr.Attempts)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, limit := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		d := p.backoff(attempt + 1)
+		assert.GreaterOrEqual(t, d, limit/2)
+		assert.LessOrEqual(t, d, limit)
+	}
+}
+
+func TestRetryBackoffUncapped(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second}
+	for _, attempt := range []int{10, 40, 100, 1000} {
+		assert.Greater(t, p.backoff(attempt), time.Duration(0), "attempt %d", attempt)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, 120*time.Second, parseRetryAfter("120", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter("Mon, 15 Jan 2024 12:00:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Mon, 15 Jan 2024 11:00:00 GMT", now))
+}
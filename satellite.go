@@ -0,0 +1,221 @@
+package omgo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SatelliteMetric represents a radiation metric that can be requested from
+// the Satellite Radiation API.
+type SatelliteMetric string
+
+// Radiation metrics available from the Open-Meteo Satellite Radiation API (W/m²).
+// Plain metrics are averages over the preceding interval; instant metrics
+// are values at the end of the interval.
+const (
+	SatelliteShortwaveRadiation     SatelliteMetric = "shortwave_radiation"
+	SatelliteDirectRadiation        SatelliteMetric = "direct_radiation"
+	SatelliteDiffuseRadiation       SatelliteMetric = "diffuse_radiation"
+	SatelliteDirectNormalIrradiance SatelliteMetric = "direct_normal_irradiance"
+	SatelliteGlobalTiltedIrradiance SatelliteMetric = "global_tilted_irradiance"
+	SatelliteTerrestrialRadiation   SatelliteMetric = "terrestrial_radiation"
+
+	SatelliteShortwaveRadiationInstant     SatelliteMetric = "shortwave_radiation_instant"
+	SatelliteDirectRadiationInstant        SatelliteMetric = "direct_radiation_instant"
+	SatelliteDiffuseRadiationInstant       SatelliteMetric = "diffuse_radiation_instant"
+	SatelliteDirectNormalIrradianceInstant SatelliteMetric = "direct_normal_irradiance_instant"
+	SatelliteGlobalTiltedIrradianceInstant SatelliteMetric = "global_tilted_irradiance_instant"
+	SatelliteTerrestrialRadiationInstant   SatelliteMetric = "terrestrial_radiation_instant"
+)
+
+// String returns the API parameter string for the metric.
+func (m SatelliteMetric) String() string {
+	return string(m)
+}
+
+// SatelliteModel selects the satellite data source used by the Satellite Radiation API.
+type SatelliteModel string
+
+const (
+	// SatelliteModelSeamless combines all satellites for global coverage (API default).
+	SatelliteModelSeamless SatelliteModel = "satellite_radiation_seamless"
+	// SatelliteModelSARAH3 uses the EUMETSAT SARAH-3 climate data record (30 minutes).
+	SatelliteModelSARAH3 SatelliteModel = "eumetsat_sarah3"
+	// SatelliteModelLSASAFMSG uses EUMETSAT LSA SAF data for Europe and Africa (15 minutes).
+	SatelliteModelLSASAFMSG SatelliteModel = "eumetsat_lsa_saf_msg"
+	// SatelliteModelLSASAFIODC uses EUMETSAT LSA SAF data for the Indian Ocean (15 minutes).
+	SatelliteModelLSASAFIODC SatelliteModel = "eumetsat_lsa_saf_iodc"
+	// SatelliteModelHimawari uses JMA JAXA Himawari data for East Asia and Oceania (10 minutes).
+	SatelliteModelHimawari SatelliteModel = "jma_jaxa_himawari"
+)
+
+// SatelliteRequest represents a request to the Satellite Radiation API.
+type SatelliteRequest struct {
+	location Location
+
+	// Metrics to request
+	hourlyMetrics []SatelliteMetric
+
+	// Time options
+	timezone           string
+	pastDays           int
+	temporalResolution TemporalResolution
+
+	// Date range options
+	startDate string
+	endDate   string
+
+	// Other options
+	models []SatelliteModel
+
+	// Solar radiation options (for global_tilted_irradiance)
+	tilt    *float64
+	azimuth *float64
+}
+
+// NewSatelliteRequest creates a new SatelliteRequest for the given coordinates.
+func NewSatelliteRequest(lat, lon float64) (*SatelliteRequest, error) {
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &SatelliteRequest{
+		location: loc,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *SatelliteRequest) WithLocation(loc Location) *SatelliteRequest {
+	r.location = loc
+	return r
+}
+
+// WithHourly adds radiation metrics to the request.
+// Despite the name, data is returned at the resolution set by WithTemporalResolution.
+func (r *SatelliteRequest) WithHourly(metrics ...SatelliteMetric) *SatelliteRequest {
+	r.hourlyMetrics = append(r.hourlyMetrics, metrics...)
+	return r
+}
+
+// WithTimezone sets the timezone for the response.
+// Use "auto" to automatically detect timezone from coordinates.
+func (r *SatelliteRequest) WithTimezone(tz string) *SatelliteRequest {
+	r.timezone = tz
+	return r
+}
+
+// WithPastDays sets the number of past days to include.
+func (r *SatelliteRequest) WithPastDays(days int) *SatelliteRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithTemporalResolution sets the time step of the returned data.
+// Use TemporalResolutionNative for the 10, 15 or 30 minute resolution of the
+// selected satellite; the default is hourly.
+func (r *SatelliteRequest) WithTemporalResolution(resolution TemporalResolution) *SatelliteRequest {
+	r.temporalResolution = resolution
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *SatelliteRequest) WithDateRange(startDate, endDate string) *SatelliteRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithModels sets specific satellite data sources to use. With several
+// sources the data of each is returned in Weather.Models; use
+// Weather.ForModel(Model(source)).
+func (r *SatelliteRequest) WithModels(models ...SatelliteModel) *SatelliteRequest {
+	r.models = append(r.models, models...)
+	return r
+}
+
+// WithTilt sets the tilt angle for global_tilted_irradiance calculations (0-90 degrees).
+func (r *SatelliteRequest) WithTilt(degrees float64) *SatelliteRequest {
+	r.tilt = &degrees
+	return r
+}
+
+// WithAzimuth sets the azimuth angle for global_tilted_irradiance calculations.
+// 0° = south, -90° = east, 90° = west, ±180° = north.
+func (r *SatelliteRequest) WithAzimuth(degrees float64) *SatelliteRequest {
+	r.azimuth = &degrees
+	return r
+}
+
+// buildURL builds the URL for a satellite radiation request.
+func (r *SatelliteRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Metrics
+	if len(r.hourlyMetrics) > 0 {
+		params.Set("hourly", joinMetrics(r.hourlyMetrics))
+	}
+
+	// Time options
+	if r.timezone != "" {
+		params.Set("timezone", r.timezone)
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+	if r.temporalResolution != "" {
+		params.Set("temporal_resolution", string(r.temporalResolution))
+	}
+
+	// Date range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+
+	// Other options
+	if len(r.models) > 0 {
+		params.Set("models", joinMetrics(r.models))
+	}
+
+	// Solar options
+	if r.tilt != nil {
+		params.Set("tilt", formatFloat(*r.tilt))
+	}
+	if r.azimuth != nil {
+		params.Set("azimuth", formatFloat(*r.azimuth))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// SatelliteRadiation retrieves satellite-derived solar radiation for the given request.
+// The data is returned in Weather.Hourly, so code reading the radiation fields of
+// BaseMetrics works unchanged; Hourly.Times reflects the requested temporal resolution.
+// When several sources are requested, the data of each is in Weather.Models.
+func (c *Client) SatelliteRadiation(ctx context.Context, req *SatelliteRequest) (*Weather, error) {
+	url := req.buildURL(c.satelliteURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]Model, len(req.models))
+	for i, m := range req.models {
+		models[i] = Model(m)
+	}
+	return parseWeatherResponse(body, models...)
+}
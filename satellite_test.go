@@ -0,0 +1,88 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSatelliteRequestURL(t *testing.T) {
+	req, err := NewSatelliteRequest(47.37, 8.55)
+	require.NoError(t, err)
+
+	req.WithHourly(SatelliteShortwaveRadiation, SatelliteGlobalTiltedIrradiance).
+		WithTemporalResolution(TemporalResolutionNative).
+		WithModels(SatelliteModelLSASAFMSG).
+		WithTilt(30).
+		WithAzimuth(-10).
+		WithDateRange("2024-06-01", "2024-06-02")
+
+	parsed, err := url.Parse(req.buildURL(satelliteBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "global_tilted_irradiance,shortwave_radiation", params.Get("hourly"))
+	assert.Equal(t, "native", params.Get("temporal_resolution"))
+	assert.Equal(t, "eumetsat_lsa_saf_msg", params.Get("models"))
+	assert.Equal(t, "30", params.Get("tilt"))
+	assert.Equal(t, "-10", params.Get("azimuth"))
+	assert.Equal(t, "2024-06-01", params.Get("start_date"))
+}
+
+func TestClientSatelliteRadiation(t *testing.T) {
+	data, err := os.ReadFile("testdata/satellite.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{response: newMockResponse(http.StatusOK, data)}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewSatelliteRequest(47.37, 8.55)
+	require.NoError(t, err)
+	req.WithHourly(SatelliteShortwaveRadiation).
+		WithTemporalResolution(TemporalResolutionNative)
+
+	weather, err := client.SatelliteRadiation(context.Background(), req)
+	require.NoError(t, err)
+
+	// Radiation is available through BaseMetrics like forecast data
+	require.NotNil(t, weather.Hourly)
+	var base *BaseMetrics = &weather.Hourly.BaseMetrics
+	require.Len(t, base.Times, 4)
+	assert.Equal(t, 15*time.Minute, base.Times[1].Sub(base.Times[0]))
+	assert.Equal(t, 612.0, base.ShortwaveRadiation[0])
+	assert.Equal(t, 731.9, base.DirectNormalIrradiance[2])
+	assert.Equal(t, 715.0, base.GlobalTiltedIrradianceInstant[2])
+	assert.True(t, base.ShortwaveRadiation.IsMissing(3))
+	assert.Equal(t, "W/m²", weather.HourlyUnits.GlobalTiltedIrradianceInstant)
+}
+
+func TestClientSatelliteRadiationModels(t *testing.T) {
+	body := []byte(`{"timezone": "GMT", "hourly": {"time": ["2024-06-01T12:00"],
+		"shortwave_radiation_eumetsat_sarah3": [610.0], "shortwave_radiation_jma_jaxa_himawari": [598.5]},
+		"hourly_units": {"time": "iso8601", "shortwave_radiation_eumetsat_sarah3": "W/m²", "shortwave_radiation_jma_jaxa_himawari": "W/m²"}}`)
+	mock := &mockHTTPClient{response: newMockResponse(http.StatusOK, body)}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewSatelliteRequest(47.37, 8.55)
+	require.NoError(t, err)
+	req.WithHourly(SatelliteShortwaveRadiation).
+		WithModels(SatelliteModelSARAH3, SatelliteModelHimawari)
+
+	weather, err := client.SatelliteRadiation(context.Background(), req)
+	require.NoError(t, err)
+
+	sarah := weather.ForModel(Model(SatelliteModelSARAH3))
+	require.NotNil(t, sarah)
+	assert.Equal(t, Series{610.0}, sarah.Hourly.ShortwaveRadiation)
+	assert.Equal(t, "W/m²", sarah.HourlyUnits.ShortwaveRadiation)
+	himawari := weather.ForModel(Model(SatelliteModelHimawari))
+	require.NotNil(t, himawari)
+	assert.Equal(t, Series{598.5}, himawari.Hourly.ShortwaveRadiation)
+	assert.Empty(t, weather.Hourly.Extra)
+}
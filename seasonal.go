@@ -0,0 +1,319 @@
+package omgo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SeasonalRequest represents a request to the Seasonal Forecast API.
+type SeasonalRequest struct {
+	location Location
+
+	// Metrics to request
+	sixHourlyMetrics []SeasonalSixHourlyMetric
+	dailyMetrics     []SeasonalDailyMetric
+
+	// Units
+	temperatureUnit   TemperatureUnit
+	windSpeedUnit     WindSpeedUnit
+	precipitationUnit PrecipitationUnit
+
+	// Time options
+	timezone     string
+	forecastDays int
+	pastDays     int
+
+	// Date range options
+	startDate string
+	endDate   string
+
+	// Other options
+	cellSelection CellSelection
+}
+
+// NewSeasonalRequest creates a new SeasonalRequest for the given coordinates.
+func NewSeasonalRequest(lat, lon float64) (*SeasonalRequest, error) {
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return &SeasonalRequest{
+		location: loc,
+	}, nil
+}
+
+// WithLocation sets the location from an existing Location struct.
+func (r *SeasonalRequest) WithLocation(loc Location) *SeasonalRequest {
+	r.location = loc
+	return r
+}
+
+// WithSixHourly adds 6-hourly metrics to the request.
+func (r *SeasonalRequest) WithSixHourly(metrics ...SeasonalSixHourlyMetric) *SeasonalRequest {
+	r.sixHourlyMetrics = append(r.sixHourlyMetrics, metrics...)
+	return r
+}
+
+// WithDaily adds daily metrics to the request.
+func (r *SeasonalRequest) WithDaily(metrics ...SeasonalDailyMetric) *SeasonalRequest {
+	r.dailyMetrics = append(r.dailyMetrics, metrics...)
+	return r
+}
+
+// WithTemperatureUnit sets the temperature unit.
+func (r *SeasonalRequest) WithTemperatureUnit(unit TemperatureUnit) *SeasonalRequest {
+	r.temperatureUnit = unit
+	return r
+}
+
+// WithWindSpeedUnit sets the wind speed unit.
+func (r *SeasonalRequest) WithWindSpeedUnit(unit WindSpeedUnit) *SeasonalRequest {
+	r.windSpeedUnit = unit
+	return r
+}
+
+// WithPrecipitationUnit sets the precipitation unit.
+func (r *SeasonalRequest) WithPrecipitationUnit(unit PrecipitationUnit) *SeasonalRequest {
+	r.precipitationUnit = unit
+	return r
+}
+
+// WithTimezone sets the timezone for the response.
+// Use "auto" to automatically detect timezone from coordinates.
+func (r *SeasonalRequest) WithTimezone(tz string) *SeasonalRequest {
+	r.timezone = tz
+	return r
+}
+
+// WithForecastDays sets the number of forecast days (up to about 9 months).
+func (r *SeasonalRequest) WithForecastDays(days int) *SeasonalRequest {
+	r.forecastDays = days
+	return r
+}
+
+// WithPastDays sets the number of past days to include.
+func (r *SeasonalRequest) WithPastDays(days int) *SeasonalRequest {
+	r.pastDays = days
+	return r
+}
+
+// WithDateRange sets a specific date range.
+// Dates should be in ISO8601 format (yyyy-mm-dd).
+func (r *SeasonalRequest) WithDateRange(startDate, endDate string) *SeasonalRequest {
+	r.startDate = startDate
+	r.endDate = endDate
+	return r
+}
+
+// WithCellSelection sets the grid-cell selection preference.
+func (r *SeasonalRequest) WithCellSelection(selection CellSelection) *SeasonalRequest {
+	r.cellSelection = selection
+	return r
+}
+
+// buildURL builds the URL for a seasonal request.
+func (r *SeasonalRequest) buildURL(baseURL, apiKey string) string {
+	params := url.Values{}
+
+	// Location
+	setLocationParams(params, []Location{r.location})
+
+	// Metrics
+	if len(r.sixHourlyMetrics) > 0 {
+		params.Set("six_hourly", joinMetrics(r.sixHourlyMetrics))
+	}
+	if len(r.dailyMetrics) > 0 {
+		params.Set("daily", joinMetrics(r.dailyMetrics))
+	}
+
+	// Units
+	if r.temperatureUnit != "" {
+		params.Set("temperature_unit", string(r.temperatureUnit))
+	}
+	if r.windSpeedUnit != "" {
+		params.Set("wind_speed_unit", string(r.windSpeedUnit))
+	}
+	if r.precipitationUnit != "" {
+		params.Set("precipitation_unit", string(r.precipitationUnit))
+	}
+
+	// Time options
+	if r.timezone != "" {
+		params.Set("timezone", r.timezone)
+	}
+	if r.forecastDays > 0 {
+		params.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if r.pastDays > 0 {
+		params.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+
+	// Date range
+	if r.startDate != "" {
+		params.Set("start_date", r.startDate)
+	}
+	if r.endDate != "" {
+		params.Set("end_date", r.endDate)
+	}
+
+	// Other options
+	if r.cellSelection != "" {
+		params.Set("cell_selection", string(r.cellSelection))
+	}
+
+	// API key for commercial access
+	if apiKey != "" {
+		params.Set("apikey", apiKey)
+	}
+
+	return baseURL + "?" + params.Encode()
+}
+
+// Seasonal contains the response from the Seasonal Forecast API.
+type Seasonal struct {
+	// Location information
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation"`
+
+	// Timezone information
+	Timezone             string `json:"timezone"`
+	TimezoneAbbreviation string `json:"timezone_abbreviation"`
+	UTCOffsetSeconds     int    `json:"utc_offset_seconds"`
+
+	// Generation time for performance monitoring
+	GenerationTimeMs float64 `json:"generationtime_ms"`
+
+	// 6-hourly data
+	SixHourly *SeasonalSixHourlyData `json:"-"` // parsed separately
+
+	// Daily data
+	Daily *SeasonalDailyData `json:"-"` // parsed separately
+}
+
+// SeasonalSixHourlyData contains 6-hourly seasonal forecast data.
+// Missing data points are stored as NaN; see Series.
+type SeasonalSixHourlyData struct {
+	// Time contains timestamps for each data point.
+	Times []time.Time
+
+	// Variables contains the members of each returned variable.
+	Variables map[SeasonalSixHourlyMetric]EnsembleMembers
+
+	// Units contains the unit string of each returned variable.
+	Units map[SeasonalSixHourlyMetric]string
+}
+
+// Members returns the ensemble members of a variable, or nil if the
+// variable was not returned.
+func (d *SeasonalSixHourlyData) Members(metric SeasonalSixHourlyMetric) EnsembleMembers {
+	if d == nil {
+		return nil
+	}
+	return d.Variables[metric]
+}
+
+// SeasonalDailyData contains daily seasonal forecast data.
+// Missing data points are stored as NaN; see Series.
+type SeasonalDailyData struct {
+	// Time contains timestamps for each day (at 00:00).
+	Times []time.Time
+
+	// Variables contains the members of each returned variable.
+	Variables map[SeasonalDailyMetric]EnsembleMembers
+
+	// Units contains the unit string of each returned variable.
+	Units map[SeasonalDailyMetric]string
+}
+
+// Members returns the ensemble members of a variable, or nil if the
+// variable was not returned.
+func (d *SeasonalDailyData) Members(metric SeasonalDailyMetric) EnsembleMembers {
+	if d == nil {
+		return nil
+	}
+	return d.Variables[metric]
+}
+
+// rawSeasonalResponse represents the raw JSON response from the Seasonal Forecast API.
+type rawSeasonalResponse struct {
+	rawMeta
+
+	SixHourly      map[string]json.RawMessage `json:"six_hourly,omitempty"`
+	SixHourlyUnits map[string]string          `json:"six_hourly_units,omitempty"`
+
+	Daily      map[string]json.RawMessage `json:"daily,omitempty"`
+	DailyUnits map[string]string          `json:"daily_units,omitempty"`
+}
+
+// parseSeasonalResponse parses the Seasonal Forecast API response into a Seasonal struct.
+func parseSeasonalResponse(body []byte) (*Seasonal, error) {
+	var raw rawSeasonalResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	// Load timezone for proper time parsing
+	loc := raw.timeLocation()
+
+	seasonal := &Seasonal{
+		Latitude:             raw.Latitude,
+		Longitude:            raw.Longitude,
+		Elevation:            raw.Elevation,
+		Timezone:             raw.Timezone,
+		TimezoneAbbreviation: raw.TimezoneAbbreviation,
+		UTCOffsetSeconds:     raw.UTCOffsetSeconds,
+		GenerationTimeMs:     raw.GenerationTimeMs,
+	}
+
+	// Parse 6-hourly data
+	if len(raw.SixHourly) > 0 {
+		times, err := parseFieldTimes(raw.SixHourly, loc, parseDateTimeArray)
+		if err != nil {
+			return nil, &DecodeError{Block: "six_hourly", Err: err}
+		}
+		variables, units, err := parseMemberBlock[SeasonalSixHourlyMetric](raw.SixHourly, raw.SixHourlyUnits)
+		if err != nil {
+			return nil, &DecodeError{Block: "six_hourly", Err: err}
+		}
+		seasonal.SixHourly = &SeasonalSixHourlyData{
+			Times:     times,
+			Variables: variables,
+			Units:     units,
+		}
+	}
+
+	// Parse daily data
+	if len(raw.Daily) > 0 {
+		times, err := parseFieldTimes(raw.Daily, loc, parseDateArray)
+		if err != nil {
+			return nil, &DecodeError{Block: "daily", Err: err}
+		}
+		variables, units, err := parseMemberBlock[SeasonalDailyMetric](raw.Daily, raw.DailyUnits)
+		if err != nil {
+			return nil, &DecodeError{Block: "daily", Err: err}
+		}
+		seasonal.Daily = &SeasonalDailyData{
+			Times:     times,
+			Variables: variables,
+			Units:     units,
+		}
+	}
+
+	return seasonal, nil
+}
+
+// Seasonal retrieves seasonal forecast data for the given request.
+func (c *Client) Seasonal(ctx context.Context, req *SeasonalRequest) (*Seasonal, error) {
+	url := req.buildURL(c.seasonalURL, c.apiKey)
+
+	body, err := c.doRequest(ctx, url, pastDateClass(req.endDate, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSeasonalResponse(body)
+}
@@ -0,0 +1,63 @@
+package omgo
+
+// SeasonalSixHourlyMetric represents a metric that can be requested for
+// 6-hourly seasonal forecast data.
+type SeasonalSixHourlyMetric string
+
+// 6-hourly metrics available from the Open-Meteo Seasonal Forecast API.
+const (
+	// Temperature and humidity
+	SeasonalTemperature2m      SeasonalSixHourlyMetric = "temperature_2m"
+	SeasonalTemperature2mMax   SeasonalSixHourlyMetric = "temperature_2m_max"
+	SeasonalTemperature2mMin   SeasonalSixHourlyMetric = "temperature_2m_min"
+	SeasonalRelativeHumidity2m SeasonalSixHourlyMetric = "relative_humidity_2m"
+	SeasonalDewPoint2m         SeasonalSixHourlyMetric = "dew_point_2m"
+
+	// Precipitation
+	SeasonalPrecipitation SeasonalSixHourlyMetric = "precipitation"
+	SeasonalShowers       SeasonalSixHourlyMetric = "showers"
+	SeasonalSnowfall      SeasonalSixHourlyMetric = "snowfall"
+
+	// Pressure, clouds and radiation
+	SeasonalPressureMSL        SeasonalSixHourlyMetric = "pressure_msl"
+	SeasonalCloudCover         SeasonalSixHourlyMetric = "cloud_cover"
+	SeasonalShortwaveRadiation SeasonalSixHourlyMetric = "shortwave_radiation"
+
+	// Wind
+	SeasonalWindSpeed10m     SeasonalSixHourlyMetric = "wind_speed_10m"
+	SeasonalWindDirection10m SeasonalSixHourlyMetric = "wind_direction_10m"
+
+	// Soil
+	SeasonalSoilTemperature0To10cm SeasonalSixHourlyMetric = "soil_temperature_0_to_10cm"
+	SeasonalSoilMoisture0To10cm    SeasonalSixHourlyMetric = "soil_moisture_0_to_10cm"
+	SeasonalSoilMoisture10To40cm   SeasonalSixHourlyMetric = "soil_moisture_10_to_40cm"
+	SeasonalSoilMoisture40To100cm  SeasonalSixHourlyMetric = "soil_moisture_40_to_100cm"
+	SeasonalSoilMoisture100To200cm SeasonalSixHourlyMetric = "soil_moisture_100_to_200cm"
+)
+
+// String returns the API parameter string for the metric.
+func (m SeasonalSixHourlyMetric) String() string {
+	return string(m)
+}
+
+// SeasonalDailyMetric represents a metric that can be requested for daily
+// seasonal forecast data.
+type SeasonalDailyMetric string
+
+// Daily metrics available from the Open-Meteo Seasonal Forecast API.
+const (
+	SeasonalDailyTemperature2mMax         SeasonalDailyMetric = "temperature_2m_max"
+	SeasonalDailyTemperature2mMin         SeasonalDailyMetric = "temperature_2m_min"
+	SeasonalDailyPrecipitationSum         SeasonalDailyMetric = "precipitation_sum"
+	SeasonalDailyRainSum                  SeasonalDailyMetric = "rain_sum"
+	SeasonalDailySnowfallSum              SeasonalDailyMetric = "snowfall_sum"
+	SeasonalDailyPrecipitationHours       SeasonalDailyMetric = "precipitation_hours"
+	SeasonalDailyShortwaveRadiationSum    SeasonalDailyMetric = "shortwave_radiation_sum"
+	SeasonalDailyWindSpeed10mMax          SeasonalDailyMetric = "wind_speed_10m_max"
+	SeasonalDailyWindDirection10mDominant SeasonalDailyMetric = "wind_direction_10m_dominant"
+)
+
+// String returns the API parameter string for the metric.
+func (m SeasonalDailyMetric) String() string {
+	return string(m)
+}
@@ -0,0 +1,77 @@
+package omgo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeasonalRequestURL(t *testing.T) {
+	req, err := NewSeasonalRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	req.WithSixHourly(SeasonalTemperature2m, SeasonalPrecipitation).
+		WithDaily(SeasonalDailyPrecipitationSum).
+		WithForecastDays(183).
+		WithTimezone("Europe/Berlin")
+
+	parsed, err := url.Parse(req.buildURL(seasonalBaseURL, ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "52.52", params.Get("latitude"))
+	assert.Equal(t, "precipitation,temperature_2m", params.Get("six_hourly"))
+	assert.Equal(t, "precipitation_sum", params.Get("daily"))
+	assert.Equal(t, "183", params.Get("forecast_days"))
+	assert.Equal(t, "Europe/Berlin", params.Get("timezone"))
+}
+
+func TestParseSeasonal(t *testing.T) {
+	data, err := os.ReadFile("testdata/seasonal.json")
+	require.NoError(t, err)
+
+	seasonal, err := parseSeasonalResponse(data)
+	require.NoError(t, err)
+
+	require.NotNil(t, seasonal.SixHourly)
+	require.Len(t, seasonal.SixHourly.Times, 4)
+	assert.Equal(t, time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC), seasonal.SixHourly.Times[1].UTC())
+
+	temp := seasonal.SixHourly.Members(SeasonalTemperature2m)
+	require.Len(t, temp, 3)
+	assert.Equal(t, 12.1, temp[0][0])
+	assert.True(t, temp[1].IsMissing(3))
+	assert.InDelta(t, 17.65, temp.Mean()[3], 1e-9)
+	assert.Equal(t, "°C", seasonal.SixHourly.Units[SeasonalTemperature2m])
+
+	require.NotNil(t, seasonal.Daily)
+	require.Len(t, seasonal.Daily.Times, 2)
+	assert.Equal(t, time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC), seasonal.Daily.Times[1].UTC())
+	precip := seasonal.Daily.Members(SeasonalDailyPrecipitationSum)
+	require.Len(t, precip, 2)
+	assert.Equal(t, Series{0.5, 0.5}, precip.ExceedanceProbability(1))
+	assert.Equal(t, "mm", seasonal.Daily.Units[SeasonalDailyPrecipitationSum])
+}
+
+func TestClientSeasonal(t *testing.T) {
+	data, err := os.ReadFile("testdata/seasonal.json")
+	require.NoError(t, err)
+
+	mock := &mockHTTPClient{response: newMockResponse(http.StatusOK, data)}
+	client := NewClient(WithHTTPClient(mock))
+
+	req, err := NewSeasonalRequest(52.52, 13.41)
+	require.NoError(t, err)
+	req.WithSixHourly(SeasonalTemperature2m)
+
+	seasonal, err := client.Seasonal(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, seasonal.SixHourly)
+	assert.Nil(t, seasonal.Daily.Members(SeasonalDailyRainSum))
+}
@@ -0,0 +1,109 @@
+package omgo
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// Series is a time series of metric values.
+// Missing data points (null in the API response) are stored as NaN so they
+// can't be mistaken for a real 0.0 reading. Use IsMissing, Present or Fill
+// to handle gaps explicitly.
+type Series []float64
+
+// UnmarshalJSON decodes a JSON array of numbers, mapping null entries to NaN.
+func (s *Series) UnmarshalJSON(data []byte) error {
+	var raw []*float64
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*s = nil
+		return nil
+	}
+	out := make(Series, len(raw))
+	for i, v := range raw {
+		if v == nil {
+			out[i] = math.NaN()
+			continue
+		}
+		out[i] = *v
+	}
+	*s = out
+	return nil
+}
+
+// MarshalJSON encodes the series as a JSON array, writing missing values as null.
+func (s Series) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	raw := make([]*float64, len(s))
+	for i := range s {
+		if !math.IsNaN(s[i]) {
+			raw[i] = &s[i]
+		}
+	}
+	return json.Marshal(raw)
+}
+
+// IsMissing reports whether the value at index i is missing.
+// Indices outside the series are reported as missing.
+func (s Series) IsMissing(i int) bool {
+	return i < 0 || i >= len(s) || math.IsNaN(s[i])
+}
+
+// At returns the value at index i and whether it is present.
+func (s Series) At(i int) (float64, bool) {
+	if s.IsMissing(i) {
+		return 0, false
+	}
+	return s[i], true
+}
+
+// HasMissing reports whether the series contains any missing values.
+func (s Series) HasMissing() bool {
+	for _, v := range s {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Missing returns the number of missing values in the series.
+func (s Series) Missing() int {
+	n := 0
+	for _, v := range s {
+		if math.IsNaN(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Present returns the values that are not missing, in their original order.
+func (s Series) Present() []float64 {
+	out := make([]float64, 0, len(s))
+	for _, v := range s {
+		if !math.IsNaN(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Fill returns a copy of the series with missing values replaced by v.
+func (s Series) Fill(v float64) Series {
+	if s == nil {
+		return nil
+	}
+	out := make(Series, len(s))
+	for i, x := range s {
+		if math.IsNaN(x) {
+			x = v
+		}
+		out[i] = x
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package omgo
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesUnmarshalNull(t *testing.T) {
+	var s Series
+	require.NoError(t, json.Unmarshal([]byte(`[1.5, null, 0]`), &s))
+
+	require.Len(t, s, 3)
+	assert.Equal(t, 1.5, s[0])
+	assert.True(t, math.IsNaN(s[1]))
+	assert.Equal(t, 0.0, s[2])
+
+	assert.False(t, s.IsMissing(0))
+	assert.True(t, s.IsMissing(1))
+	assert.False(t, s.IsMissing(2))
+	assert.True(t, s.IsMissing(3)) // out of range
+	assert.True(t, s.HasMissing())
+	assert.Equal(t, 1, s.Missing())
+}
+
+func TestSeriesUnmarshalNullArray(t *testing.T) {
+	s := Series{1}
+	require.NoError(t, json.Unmarshal([]byte(`null`), &s))
+	assert.Nil(t, s)
+}
+
+func TestSeriesMarshal(t *testing.T) {
+	s := Series{1.5, math.NaN(), 0}
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[1.5, null, 0]`, string(data))
+}
+
+func TestSeriesHelpers(t *testing.T) {
+	s := Series{1, math.NaN(), 3}
+
+	v, ok := s.At(0)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, v)
+
+	_, ok = s.At(1)
+	assert.False(t, ok)
+
+	assert.Equal(t, []float64{1, 3}, s.Present())
+	assert.Equal(t, Series{1, -1, 3}, s.Fill(-1))
+	assert.True(t, math.IsNaN(s[1]), "Fill must not modify the original")
+
+	assert.False(t, Series{1, 2}.HasMissing())
+	assert.Nil(t, Series(nil).Fill(0))
+}
@@ -26,6 +26,36 @@ const (
 	Inches      PrecipitationUnit = "inch"
 )
 
+// LengthUnit specifies the unit for wave heights and other lengths in the Marine API.
+type LengthUnit string
+
+const (
+	Metric   LengthUnit = "metric"
+	Imperial LengthUnit = "imperial"
+)
+
+// TemporalResolution specifies the time step of hourly data.
+type TemporalResolution string
+
+const (
+	// TemporalResolutionNative returns data at the native model or satellite
+	// resolution (e.g. 10, 15 or 30 minutes for satellite radiation).
+	TemporalResolutionNative  TemporalResolution = "native"
+	TemporalResolutionHourly  TemporalResolution = "hourly_1"
+	TemporalResolutionHourly3 TemporalResolution = "hourly_3"
+	TemporalResolutionHourly6 TemporalResolution = "hourly_6"
+)
+
+// ResponseFormat specifies the encoding of API responses.
+type ResponseFormat string
+
+const (
+	FormatJSON ResponseFormat = "json"
+	// FormatFlatBuffers requests the compact binary FlatBuffers encoding,
+	// which is considerably faster to decode for large responses.
+	FormatFlatBuffers ResponseFormat = "flatbuffers"
+)
+
 // CellSelection specifies how grid-cells are selected.
 type CellSelection string
 
@@ -37,35 +67,42 @@ const (
 
 // BaseUnits contains unit strings for metrics shared between hourly and minutely15 data.
 type BaseUnits struct {
-	Temperature2m            string `json:"temperature_2m,omitempty"`
-	RelativeHumidity2m       string `json:"relative_humidity_2m,omitempty"`
-	DewPoint2m               string `json:"dew_point_2m,omitempty"`
-	ApparentTemperature      string `json:"apparent_temperature,omitempty"`
-	Precipitation            string `json:"precipitation,omitempty"`
-	Rain                     string `json:"rain,omitempty"`
-	Snowfall                 string `json:"snowfall,omitempty"`
-	WeatherCode              string `json:"weather_code,omitempty"`
-	CloudCover               string `json:"cloud_cover,omitempty"`
-	CloudCoverLow            string `json:"cloud_cover_low,omitempty"`
-	CloudCoverMid            string `json:"cloud_cover_mid,omitempty"`
-	CloudCoverHigh           string `json:"cloud_cover_high,omitempty"`
-	WindSpeed10m             string `json:"wind_speed_10m,omitempty"`
-	WindSpeed80m             string `json:"wind_speed_80m,omitempty"`
-	WindDirection10m         string `json:"wind_direction_10m,omitempty"`
-	WindDirection80m         string `json:"wind_direction_80m,omitempty"`
-	WindGusts10m             string `json:"wind_gusts_10m,omitempty"`
-	ShortwaveRadiation       string `json:"shortwave_radiation,omitempty"`
-	DirectRadiation          string `json:"direct_radiation,omitempty"`
-	DirectNormalIrradiance   string `json:"direct_normal_irradiance,omitempty"`
-	DiffuseRadiation         string `json:"diffuse_radiation,omitempty"`
-	GlobalTiltedIrradiance   string `json:"global_tilted_irradiance,omitempty"`
-	Visibility               string `json:"visibility,omitempty"`
-	Evapotranspiration       string `json:"evapotranspiration,omitempty"`
-	ET0FAOEvapotranspiration string `json:"et0_fao_evapotranspiration,omitempty"`
-	VapourPressureDeficit    string `json:"vapour_pressure_deficit,omitempty"`
-	Cape                     string `json:"cape,omitempty"`
-	FreezingLevelHeight      string `json:"freezing_level_height,omitempty"`
-	SunshineDuration         string `json:"sunshine_duration,omitempty"`
+	Temperature2m                 string `json:"temperature_2m,omitempty"`
+	RelativeHumidity2m            string `json:"relative_humidity_2m,omitempty"`
+	DewPoint2m                    string `json:"dew_point_2m,omitempty"`
+	ApparentTemperature           string `json:"apparent_temperature,omitempty"`
+	Precipitation                 string `json:"precipitation,omitempty"`
+	Rain                          string `json:"rain,omitempty"`
+	Snowfall                      string `json:"snowfall,omitempty"`
+	WeatherCode                   string `json:"weather_code,omitempty"`
+	CloudCover                    string `json:"cloud_cover,omitempty"`
+	CloudCoverLow                 string `json:"cloud_cover_low,omitempty"`
+	CloudCoverMid                 string `json:"cloud_cover_mid,omitempty"`
+	CloudCoverHigh                string `json:"cloud_cover_high,omitempty"`
+	WindSpeed10m                  string `json:"wind_speed_10m,omitempty"`
+	WindSpeed80m                  string `json:"wind_speed_80m,omitempty"`
+	WindDirection10m              string `json:"wind_direction_10m,omitempty"`
+	WindDirection80m              string `json:"wind_direction_80m,omitempty"`
+	WindGusts10m                  string `json:"wind_gusts_10m,omitempty"`
+	ShortwaveRadiation            string `json:"shortwave_radiation,omitempty"`
+	DirectRadiation               string `json:"direct_radiation,omitempty"`
+	DirectNormalIrradiance        string `json:"direct_normal_irradiance,omitempty"`
+	DiffuseRadiation              string `json:"diffuse_radiation,omitempty"`
+	GlobalTiltedIrradiance        string `json:"global_tilted_irradiance,omitempty"`
+	TerrestrialRadiation          string `json:"terrestrial_radiation,omitempty"`
+	ShortwaveRadiationInstant     string `json:"shortwave_radiation_instant,omitempty"`
+	DirectRadiationInstant        string `json:"direct_radiation_instant,omitempty"`
+	DirectNormalIrradianceInstant string `json:"direct_normal_irradiance_instant,omitempty"`
+	DiffuseRadiationInstant       string `json:"diffuse_radiation_instant,omitempty"`
+	GlobalTiltedIrradianceInstant string `json:"global_tilted_irradiance_instant,omitempty"`
+	TerrestrialRadiationInstant   string `json:"terrestrial_radiation_instant,omitempty"`
+	Visibility                    string `json:"visibility,omitempty"`
+	Evapotranspiration            string `json:"evapotranspiration,omitempty"`
+	ET0FAOEvapotranspiration      string `json:"et0_fao_evapotranspiration,omitempty"`
+	VapourPressureDeficit         string `json:"vapour_pressure_deficit,omitempty"`
+	Cape                          string `json:"cape,omitempty"`
+	FreezingLevelHeight           string `json:"freezing_level_height,omitempty"`
+	SunshineDuration              string `json:"sunshine_duration,omitempty"`
 }
 
 // HourlyUnits contains unit strings for all hourly metrics.
@@ -241,6 +278,9 @@ type HourlyUnits struct {
 	DewPoint70hPa   string `json:"dew_point_70hPa,omitempty"`
 	DewPoint50hPa   string `json:"dew_point_50hPa,omitempty"`
 	DewPoint30hPa   string `json:"dew_point_30hPa,omitempty"`
+
+	// Extra contains the units of returned variables that have no field above.
+	Extra map[string]string `json:"-"`
 }
 
 // Minutely15Units contains unit strings for 15-minutely metrics.
@@ -249,6 +289,9 @@ type Minutely15Units struct {
 
 	LightningPotential string `json:"lightning_potential,omitempty"`
 	SnowfallHeight     string `json:"snowfall_height,omitempty"`
+
+	// Extra contains the units of returned variables that have no field above.
+	Extra map[string]string `json:"-"`
 }
 
 // DailyUnits contains unit strings for daily metrics.
@@ -279,6 +322,9 @@ type DailyUnits struct {
 	ET0FAOEvapotranspiration     string `json:"et0_fao_evapotranspiration,omitempty"`
 	UVIndexMax                   string `json:"uv_index_max,omitempty"`
 	UVIndexClearSkyMax           string `json:"uv_index_clear_sky_max,omitempty"`
+
+	// Extra contains the units of returned variables that have no field above.
+	Extra map[string]string `json:"-"`
 }
 
 // CurrentUnits contains unit strings for current weather metrics.
@@ -300,4 +346,91 @@ type CurrentUnits struct {
 	WindSpeed10m        string `json:"wind_speed_10m,omitempty"`
 	WindDirection10m    string `json:"wind_direction_10m,omitempty"`
 	WindGusts10m        string `json:"wind_gusts_10m,omitempty"`
+
+	// Extra contains the units of returned variables that have no field above.
+	Extra map[string]string `json:"-"`
+}
+
+// For returns the unit of the given metric, or "" if it was not returned.
+func (u *HourlyUnits) For(metric HourlyMetric) string {
+	if u == nil {
+		return ""
+	}
+	if unit := hourlyUnitFields().str(u, string(metric)); unit != "" {
+		return unit
+	}
+	return u.Extra[string(metric)]
+}
+
+// UnmarshalJSON decodes hourly units, keeping units of unknown variables in Extra.
+func (u *HourlyUnits) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	u.Extra, err = decodeFields[string](fields, u, hourlyUnitFields())
+	return err
+}
+
+// For returns the unit of the given metric, or "" if it was not returned.
+func (u *Minutely15Units) For(metric Minutely15Metric) string {
+	if u == nil {
+		return ""
+	}
+	if unit := minutely15UnitFields().str(u, string(metric)); unit != "" {
+		return unit
+	}
+	return u.Extra[string(metric)]
+}
+
+// UnmarshalJSON decodes 15-minutely units, keeping units of unknown variables in Extra.
+func (u *Minutely15Units) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	u.Extra, err = decodeFields[string](fields, u, minutely15UnitFields())
+	return err
+}
+
+// For returns the unit of the given metric, or "" if it was not returned.
+func (u *DailyUnits) For(metric DailyMetric) string {
+	if u == nil {
+		return ""
+	}
+	if unit := dailyUnitFields().str(u, string(metric)); unit != "" {
+		return unit
+	}
+	return u.Extra[string(metric)]
+}
+
+// UnmarshalJSON decodes daily units, keeping units of unknown variables in Extra.
+func (u *DailyUnits) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	u.Extra, err = decodeFields[string](fields, u, dailyUnitFields())
+	return err
+}
+
+// For returns the unit of the given metric, or "" if it was not returned.
+func (u *CurrentUnits) For(metric CurrentMetric) string {
+	if u == nil {
+		return ""
+	}
+	if unit := currentUnitFields().str(u, string(metric)); unit != "" {
+		return unit
+	}
+	return u.Extra[string(metric)]
+}
+
+// UnmarshalJSON decodes current units, keeping units of unknown variables in Extra.
+func (u *CurrentUnits) UnmarshalJSON(data []byte) error {
+	fields, err := unmarshalFields(data)
+	if err != nil {
+		return err
+	}
+	u.Extra, err = decodeFields[string](fields, u, currentUnitFields())
+	return err
 }
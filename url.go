@@ -8,8 +8,19 @@ import (
 )
 
 const (
-	forecastBaseURL   = "https://api.open-meteo.com/v1/forecast"
-	historicalBaseURL = "https://archive-api.open-meteo.com/v1/archive"
+	forecastBaseURL           = "https://api.open-meteo.com/v1/forecast"
+	historicalBaseURL         = "https://archive-api.open-meteo.com/v1/archive"
+	geocodingBaseURL          = "https://geocoding-api.open-meteo.com/v1/search"
+	elevationBaseURL          = "https://api.open-meteo.com/v1/elevation"
+	airQualityBaseURL         = "https://air-quality-api.open-meteo.com/v1/air-quality"
+	marineBaseURL             = "https://marine-api.open-meteo.com/v1/marine"
+	floodBaseURL              = "https://flood-api.open-meteo.com/v1/flood"
+	ensembleBaseURL           = "https://ensemble-api.open-meteo.com/v1/ensemble"
+	climateBaseURL            = "https://climate-api.open-meteo.com/v1/climate"
+	seasonalBaseURL           = "https://seasonal-api.open-meteo.com/v1/seasonal"
+	historicalForecastBaseURL = "https://historical-forecast-api.open-meteo.com/v1/forecast"
+	previousRunsBaseURL       = "https://previous-runs-api.open-meteo.com/v1/forecast"
+	satelliteBaseURL          = "https://satellite-api.open-meteo.com/v1/archive"
 )
 
 // buildURL builds the URL for a forecast request.
@@ -17,11 +28,7 @@ func (r *ForecastRequest) buildURL(baseURL, apiKey string) string {
 	params := url.Values{}
 
 	// Location
-	params.Set("latitude", formatFloat(r.location.Latitude))
-	params.Set("longitude", formatFloat(r.location.Longitude))
-	if r.location.Elevation != nil {
-		params.Set("elevation", formatFloat(*r.location.Elevation))
-	}
+	setLocationParams(params, r.locations)
 
 	// Metrics
 	if len(r.hourlyMetrics) > 0 {
@@ -84,7 +91,10 @@ func (r *ForecastRequest) buildURL(baseURL, apiKey string) string {
 		params.Set("cell_selection", string(r.cellSelection))
 	}
 	if len(r.models) > 0 {
-		params.Set("models", strings.Join(r.models, ","))
+		params.Set("models", joinMetrics(r.models))
+	}
+	if r.format != "" {
+		params.Set("format", string(r.format))
 	}
 
 	// Solar options
@@ -108,11 +118,7 @@ func (r *HistoricalRequest) buildURL(baseURL, apiKey string) string {
 	params := url.Values{}
 
 	// Location
-	params.Set("latitude", formatFloat(r.location.Latitude))
-	params.Set("longitude", formatFloat(r.location.Longitude))
-	if r.location.Elevation != nil {
-		params.Set("elevation", formatFloat(*r.location.Elevation))
-	}
+	setLocationParams(params, r.locations)
 
 	// Required date range
 	params.Set("start_date", r.startDate)
@@ -144,6 +150,9 @@ func (r *HistoricalRequest) buildURL(baseURL, apiKey string) string {
 	if r.cellSelection != "" {
 		params.Set("cell_selection", string(r.cellSelection))
 	}
+	if r.format != "" {
+		params.Set("format", string(r.format))
+	}
 
 	// Solar options
 	if r.tilt != nil {
@@ -163,6 +172,26 @@ func (r *HistoricalRequest) buildURL(baseURL, apiKey string) string {
 
 // Helper functions
 
+// setLocationParams sets the coordinate parameters for one or more locations.
+// Multiple locations are encoded as comma-separated lists in the same order.
+func setLocationParams(params url.Values, locs []Location) {
+	lats := make([]string, len(locs))
+	lons := make([]string, len(locs))
+	var elevations []string
+	for i, loc := range locs {
+		lats[i] = formatFloat(loc.Latitude)
+		lons[i] = formatFloat(loc.Longitude)
+		if loc.Elevation != nil {
+			elevations = append(elevations, formatFloat(*loc.Elevation))
+		}
+	}
+	params.Set("latitude", strings.Join(lats, ","))
+	params.Set("longitude", strings.Join(lons, ","))
+	if len(elevations) > 0 {
+		params.Set("elevation", strings.Join(elevations, ","))
+	}
+}
+
 func formatFloat(f float64) string {
 	return strconv.FormatFloat(f, 'f', -1, 64)
 }
@@ -171,8 +200,14 @@ func formatFloat(f float64) string {
 // Deduplicates and sorts metrics for deterministic URLs.
 // Works with any metric type (HourlyMetric, DailyMetric, CurrentMetric, Minutely15Metric).
 func joinMetrics[T ~string](metrics []T) string {
+	return strings.Join(sortedMetrics(metrics), ",")
+}
+
+// sortedMetrics returns the metrics as sorted, deduplicated strings, in the
+// order they are sent to the API.
+func sortedMetrics[T ~string](metrics []T) []string {
 	if len(metrics) == 0 {
-		return ""
+		return nil
 	}
 
 	// Clone to avoid mutating the caller's slice, then sort and deduplicate
@@ -180,10 +215,10 @@ func joinMetrics[T ~string](metrics []T) string {
 	slices.Sort(sorted)
 	sorted = slices.Compact(sorted)
 
-	// Convert to []string for joining
+	// Convert to []string
 	strs := make([]string, len(sorted))
 	for i, m := range sorted {
 		strs[i] = string(m)
 	}
-	return strings.Join(strs, ",")
+	return strs
 }
@@ -54,7 +54,9 @@ func TestForecastRequestWithAllOptions(t *testing.T) {
 		WithPastDays(2).
 		WithCellSelection(CellSelectionNearest).
 		WithTilt(tilt).
-		WithAzimuth(azimuth)
+		WithAzimuth(azimuth).
+		WithModels(ModelICONSeamless, ModelECMWFIFS025).
+		WithFormat(FormatFlatBuffers)
 
 	rawURL := req.buildURL("https://api.open-meteo.com/v1/forecast", "")
 	parsed, err := url.Parse(rawURL)
@@ -76,6 +78,17 @@ func TestForecastRequestWithAllOptions(t *testing.T) {
 	assert.Equal(t, "nearest", params.Get("cell_selection"))
 	assert.Equal(t, "45", params.Get("tilt"))
 	assert.Equal(t, "180", params.Get("azimuth"))
+	assert.Equal(t, "ecmwf_ifs025,icon_seamless", params.Get("models"))
+	assert.Equal(t, "flatbuffers", params.Get("format"))
+}
+
+func TestForecastRequestModelNames(t *testing.T) {
+	req, err := NewForecastRequest(52.52, 13.41)
+	require.NoError(t, err)
+
+	names := []string{"icon_seamless", "gfs_seamless"}
+	req.WithModelNames(names...)
+	assert.Equal(t, []Model{ModelICONSeamless, ModelGFSSeamless}, req.models)
 }
 
 func TestHistoricalRequestURL(t *testing.T) {
@@ -140,6 +153,26 @@ func TestHistoricalRequestValidation(t *testing.T) {
 	assert.Contains(t, err.Error(), "endDate is required")
 }
 
+func TestHistoricalForecastRequestValidation(t *testing.T) {
+	// Missing start date
+	_, err := NewHistoricalForecastRequest(52.52, 13.41, "", "2023-06-30")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "startDate is required")
+
+	// Missing end date
+	_, err = NewHistoricalForecastRequest(52.52, 13.41, "2023-06-01", "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "endDate is required")
+
+	// Date range is sent as start_date/end_date
+	req, err := NewHistoricalForecastRequest(52.52, 13.41, "2023-06-01", "2023-06-30")
+	require.NoError(t, err)
+	parsed, err := url.Parse(req.buildURL(historicalForecastBaseURL, ""))
+	require.NoError(t, err)
+	assert.Equal(t, "2023-06-01", parsed.Query().Get("start_date"))
+	assert.Equal(t, "2023-06-30", parsed.Query().Get("end_date"))
+}
+
 func TestLocationValidation(t *testing.T) {
 	// Valid location
 	loc, err := NewLocation(52.52, 13.41)
@@ -199,3 +232,52 @@ func TestMetricsDeduplication(t *testing.T) {
 	// Should be deduplicated and sorted
 	assert.Equal(t, "precipitation,temperature_2m,wind_speed_10m", params.Get("hourly"))
 }
+
+func TestMultiLocationRequestURL(t *testing.T) {
+	berlin, err := NewLocation(52.52, 13.41)
+	require.NoError(t, err)
+	paris, err := NewLocation(48.85, 2.35)
+	require.NoError(t, err)
+
+	req, err := NewForecastRequestForLocations(berlin, paris)
+	require.NoError(t, err)
+	req.WithHourly(HourlyTemperature2m)
+
+	parsed, err := url.Parse(req.buildURL("https://api.open-meteo.com/v1/forecast", ""))
+	require.NoError(t, err)
+
+	params := parsed.Query()
+	assert.Equal(t, "52.52,48.85", params.Get("latitude"))
+	assert.Equal(t, "13.41,2.35", params.Get("longitude"))
+	assert.Empty(t, params.Get("elevation"))
+
+	// Elevation list follows the location order
+	hreq, err := NewHistoricalRequestForLocations(
+		[]Location{berlin.WithElevation(34), paris.WithElevation(35)},
+		"2023-01-01", "2023-01-31",
+	)
+	require.NoError(t, err)
+
+	parsed, err = url.Parse(hreq.buildURL("https://archive-api.open-meteo.com/v1/archive", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "34,35", parsed.Query().Get("elevation"))
+}
+
+func TestMultiLocationValidation(t *testing.T) {
+	berlin, err := NewLocation(52.52, 13.41)
+	require.NoError(t, err)
+
+	_, err = NewForecastRequestForLocations()
+	assert.Error(t, err)
+
+	_, err = NewForecastRequestForLocations(berlin, Location{Latitude: 95})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "location 1")
+
+	_, err = NewForecastRequestForLocations(berlin, berlin.WithElevation(10))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "elevation")
+
+	_, err = NewHistoricalRequestForLocations([]Location{berlin}, "", "2023-01-31")
+	assert.Error(t, err)
+}
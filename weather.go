@@ -31,4 +31,53 @@ type Weather struct {
 	// Daily data
 	Daily      *DailyData  `json:"-"` // parsed separately
 	DailyUnits *DailyUnits `json:"daily_units,omitempty"`
+
+	// Models contains the data of each model requested with WithModels.
+	// When several models are requested the API suffixes every variable
+	// with the model name, so the data of each model is found here instead
+	// of in the fields above. Those only hold the timestamps and variables
+	// returned without a suffix, which are shared by all models and also
+	// appear in the data of every model. Variables unknown to this package
+	// are kept in the Extra map of each model's data blocks; suffixed
+	// variables are not added to the Extra maps of the fields above.
+	Models map[Model]*ModelData `json:"-"` // parsed separately
+}
+
+// ModelData contains the data returned for a single weather model.
+type ModelData struct {
+	Current      *CurrentData
+	CurrentUnits *CurrentUnits
+
+	Hourly      *HourlyData
+	HourlyUnits *HourlyUnits
+
+	Minutely15      *Minutely15Data
+	Minutely15Units *Minutely15Units
+
+	Daily      *DailyData
+	DailyUnits *DailyUnits
+}
+
+// ForModel returns the data of the given model, or nil if the model was not
+// requested. Variables unknown to this package are in the Extra map of the
+// returned blocks; see Weather.Models.
+func (w *Weather) ForModel(m Model) *ModelData {
+	if w == nil {
+		return nil
+	}
+	return w.Models[m]
+}
+
+// data returns the blocks of the response as ModelData.
+func (w *Weather) data() *ModelData {
+	return &ModelData{
+		Current:         w.Current,
+		CurrentUnits:    w.CurrentUnits,
+		Hourly:          w.Hourly,
+		HourlyUnits:     w.HourlyUnits,
+		Minutely15:      w.Minutely15,
+		Minutely15Units: w.Minutely15Units,
+		Daily:           w.Daily,
+		DailyUnits:      w.DailyUnits,
+	}
 }
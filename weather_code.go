@@ -38,6 +38,40 @@ const (
 	ThunderstormWithHailHeavy  WeatherCode = 99
 )
 
+// WeatherCodes is a time series of weather codes. Like Series, missing codes
+// (null in the API response) are stored as NaN so they can't be mistaken for
+// ClearSky. Use At to read a code as a WeatherCode.
+type WeatherCodes Series
+
+// UnmarshalJSON decodes a JSON array of codes, mapping null entries to NaN.
+func (c *WeatherCodes) UnmarshalJSON(data []byte) error {
+	return (*Series)(c).UnmarshalJSON(data)
+}
+
+// MarshalJSON encodes the codes as a JSON array, writing missing codes as null.
+func (c WeatherCodes) MarshalJSON() ([]byte, error) {
+	return Series(c).MarshalJSON()
+}
+
+// IsMissing reports whether the code at index i is missing.
+// Indices outside the series are reported as missing.
+func (c WeatherCodes) IsMissing(i int) bool {
+	return Series(c).IsMissing(i)
+}
+
+// At returns the code at index i and whether it is present.
+func (c WeatherCodes) At(i int) (WeatherCode, bool) {
+	if c.IsMissing(i) {
+		return 0, false
+	}
+	return WeatherCode(c[i]), true
+}
+
+// HasMissing reports whether any code is missing.
+func (c WeatherCodes) HasMissing() bool {
+	return Series(c).HasMissing()
+}
+
 // String returns a human-readable description of the weather code.
 func (w WeatherCode) String() string {
 	switch w {
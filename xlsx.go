@@ -0,0 +1,143 @@
+package omgo
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// xlsxSheet is a worksheet of an exported workbook.
+type xlsxSheet struct {
+	name  string
+	table *Table
+}
+
+// xlsxPart is a file of the workbook package.
+type xlsxPart struct {
+	name  string
+	write func(w *bufio.Writer)
+}
+
+const xlsxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+// writeXLSX writes the sheets as a minimal Office Open XML workbook.
+// Values are written as numbers and times as ISO8601 text, matching CSV output.
+func writeXLSX(out io.Writer, sheets []xlsxSheet) error {
+	zw := zip.NewWriter(out)
+
+	parts := []xlsxPart{
+		{"[Content_Types].xml", func(w *bufio.Writer) { writeXLSXContentTypes(w, len(sheets)) }},
+		{"_rels/.rels", writeXLSXRootRels},
+		{"xl/workbook.xml", func(w *bufio.Writer) { writeXLSXWorkbook(w, sheets) }},
+		{"xl/_rels/workbook.xml.rels", func(w *bufio.Writer) { writeXLSXWorkbookRels(w, len(sheets)) }},
+	}
+	for i, sheet := range sheets {
+		table := sheet.table
+		parts = append(parts, xlsxPart{
+			fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1),
+			func(w *bufio.Writer) { writeXLSXSheet(w, table) },
+		})
+	}
+
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(f)
+		part.write(w)
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeXLSXContentTypes(w *bufio.Writer, sheets int) {
+	w.WriteString(xlsxHeader)
+	w.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	w.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	w.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	w.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(w, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	w.WriteString(`</Types>`)
+}
+
+func writeXLSXRootRels(w *bufio.Writer) {
+	w.WriteString(xlsxHeader)
+	w.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	w.WriteString(`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>`)
+	w.WriteString(`</Relationships>`)
+}
+
+func writeXLSXWorkbook(w *bufio.Writer, sheets []xlsxSheet) {
+	w.WriteString(xlsxHeader)
+	w.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, sheet := range sheets {
+		w.WriteString(`<sheet name="`)
+		xml.EscapeText(w, []byte(sheet.name))
+		fmt.Fprintf(w, `" sheetId="%d" r:id="rId%d"/>`, i+1, i+1)
+	}
+	w.WriteString(`</sheets></workbook>`)
+}
+
+func writeXLSXWorkbookRels(w *bufio.Writer, sheets int) {
+	w.WriteString(xlsxHeader)
+	w.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(w, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	w.WriteString(`</Relationships>`)
+}
+
+func writeXLSXSheet(w *bufio.Writer, table *Table) {
+	w.WriteString(xlsxHeader)
+	w.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	// Header row
+	w.WriteString(`<row r="1">`)
+	for col, header := range table.headers() {
+		writeXLSXString(w, col, 1, header)
+	}
+	w.WriteString(`</row>`)
+
+	for i, t := range table.Times {
+		row := i + 2
+		fmt.Fprintf(w, `<row r="%d">`, row)
+		writeXLSXString(w, 0, row, t.Format(table.timeLayout))
+		for j, c := range table.Columns {
+			text := c.cell(i)
+			switch {
+			case text == "":
+				// Missing values are left as empty cells
+			case c.Times != nil:
+				writeXLSXString(w, j+1, row, text)
+			default:
+				fmt.Fprintf(w, `<c r="%s%d"><v>%s</v></c>`, xlsxColumn(j+1), row, text)
+			}
+		}
+		w.WriteString(`</row>`)
+	}
+
+	w.WriteString(`</sheetData></worksheet>`)
+}
+
+// writeXLSXString writes an inline string cell.
+func writeXLSXString(w *bufio.Writer, col, row int, text string) {
+	fmt.Fprintf(w, `<c r="%s%d" t="inlineStr"><is><t>`, xlsxColumn(col), row)
+	xml.EscapeText(w, []byte(text))
+	w.WriteString(`</t></is></c>`)
+}
+
+// xlsxColumn returns the spreadsheet column name of a zero-based index (A, B, ..., Z, AA, ...).
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}